@@ -0,0 +1,7 @@
+data "storagegrid_bucket_compliance" "example" {
+  bucket_name = "example-legacy-compliant-bucket"
+}
+
+output "example_bucket_retention_period_minutes" {
+  value = data.storagegrid_bucket_compliance.example.retention_period_minutes
+}
@@ -0,0 +1,7 @@
+data "storagegrid_bucket_cors" "example" {
+  bucket_name = "example-bucket-name"
+}
+
+output "example_bucket_cors_rules" {
+  value = data.storagegrid_bucket_cors.example.cors_rule
+}
@@ -0,0 +1,7 @@
+data "storagegrid_bucket_metadata_notification" "example" {
+  bucket_name = "example-bucket-name"
+}
+
+output "example_bucket_metadata_notification_rules" {
+  value = data.storagegrid_bucket_metadata_notification.example.rule
+}
@@ -0,0 +1,7 @@
+data "storagegrid_bucket_notification" "example" {
+  bucket_name = "example-bucket-name"
+}
+
+output "example_bucket_notification_topics" {
+  value = data.storagegrid_bucket_notification.example.topic
+}
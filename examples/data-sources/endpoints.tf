@@ -0,0 +1,5 @@
+data "storagegrid_endpoints" "example" {}
+
+output "example_endpoint_urns" {
+  value = data.storagegrid_endpoints.example.data[*].endpoint_urn
+}
@@ -24,3 +24,10 @@ data "storagegrid_group" "group_fed_name" {
 output "group_fed_name" {
   value = data.storagegrid_group.group_fed_name
 }
+
+# This will synchronize the identity source first and wait for a federated group, which has just been added to the LDAP server
+data "storagegrid_group" "group_fed_new" {
+  unique_name                 = "federated-group/xxxxxxxxx"
+  synchronize_identity_source = true
+  synchronize_timeout         = "5m"
+}
@@ -0,0 +1,14 @@
+data "storagegrid_tenant_usage" "current" {
+  include_buckets = ["example-bucket-name"]
+}
+
+output "example_tenant_data_bytes" {
+  value = data.storagegrid_tenant_usage.current.data_bytes
+}
+
+# Allow the bucket to grow by 50% of its current size
+resource "storagegrid_bucket_quota" "example" {
+  bucket_name = "example-bucket-name"
+
+  object_bytes = ceil(data.storagegrid_tenant_usage.current.buckets[0].data_bytes * 1.5)
+}
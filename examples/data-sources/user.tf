@@ -24,3 +24,10 @@ data "storagegrid_user" "user_fed_name" {
 output "user_fed_name" {
   value = data.storagegrid_user.user_fed_name
 }
+
+# This will synchronize the identity source first and wait for a federated user, which has just been added to the LDAP server
+data "storagegrid_user" "user_fed_new" {
+  unique_name                 = "federated-user/xxxxxxxxx"
+  synchronize_identity_source = true
+  synchronize_timeout         = "5m"
+}
@@ -0,0 +1,14 @@
+provider "storagegrid" {
+  alias      = "grid"
+  address    = "https://grid.firm.com:9443"
+  username   = "root"
+  password   = "change_me"
+  grid_admin = true
+}
+
+resource "storagegrid_grid_tenant" "example" {
+  provider = storagegrid.grid
+
+  name         = "example"
+  capabilities = ["management", "s3"]
+}
@@ -0,0 +1,8 @@
+provider "storagegrid" {
+  address = "https://grid.firm.com:9443"
+  tenant  = "<int>" # Tenant ID
+  saml    = true
+
+  # prints the SAML response of the identity provider for the SAML request URL in STORAGEGRID_SAML_REQUEST_URL
+  saml_response_command = ["/usr/local/bin/idp-login", "--user", "ci-terraform"]
+}
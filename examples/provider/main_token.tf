@@ -0,0 +1,6 @@
+provider "storagegrid" {
+  address = "https://grid.firm.com:9443"
+
+  # e.g. rendered by a Vault agent, read again when StorageGRID rejects the expired token
+  token_file = "/run/secrets/storagegrid-token"
+}
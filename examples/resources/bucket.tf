@@ -17,3 +17,15 @@ resource "storagegrid_bucket" "example_full" {
     days = 30
   }
 }
+
+# updates the last access time of objects on retrieval, as required by ILM rules based on the last access time.
+resource "storagegrid_bucket" "example_last_access_time" {
+  name                     = "example-bucket-last-access-time"
+  last_access_time_enabled = true
+}
+
+# multi-site deployments may require a stricter consistency level than StorageGRID's default.
+resource "storagegrid_bucket" "example_consistency_level" {
+  name              = "example-bucket-consistency-level"
+  consistency_level = "strong-site"
+}
@@ -0,0 +1,7 @@
+resource "storagegrid_bucket_compliance" "example" {
+  bucket_name = "example-legacy-compliant-bucket"
+
+  auto_delete              = false
+  legal_hold               = false
+  retention_period_minutes = 525600
+}
@@ -0,0 +1,16 @@
+resource "storagegrid_bucket" "example_default_region" {
+  name = "example-bucket-default-region"
+}
+
+resource "storagegrid_bucket_cors" "example" {
+  bucket_name = storagegrid_bucket.example_default_region.name
+
+  cors_rule {
+    id              = "web-app"
+    allowed_origins = ["https://www.example.com"]
+    allowed_methods = ["GET", "HEAD"]
+    allowed_headers = ["*"]
+    expose_headers  = ["ETag"]
+    max_age_seconds = 3000
+  }
+}
@@ -0,0 +1,54 @@
+resource "storagegrid_bucket" "example" {
+  name = "example-bucket-lifecycle"
+}
+
+resource "storagegrid_bucket_versioning" "example" {
+  bucket_name = storagegrid_bucket.example.name
+  status      = "Enabled"
+}
+
+data "storagegrid_user" "example" {
+  unique_name = "user/example-s3-user"
+}
+
+resource "storagegrid_s3_access_key" "example" {
+  user_uuid = data.storagegrid_user.example.id
+}
+
+resource "storagegrid_bucket_lifecycle_configuration" "example" {
+  bucket_name       = storagegrid_bucket_versioning.example.bucket_name
+  s3_endpoint       = "https://s3.example.com:10443"
+  access_key        = storagegrid_s3_access_key.example.access_key
+  secret_access_key = storagegrid_s3_access_key.example.secret_access_key
+
+  rule {
+    id = "expire-logs"
+
+    filter {
+      prefix = "logs/"
+    }
+
+    expiration {
+      days = 30
+    }
+
+    noncurrent_version_expiration {
+      noncurrent_days = 7
+    }
+  }
+
+  rule {
+    id     = "expire-temporary-objects"
+    status = "Disabled"
+
+    filter {
+      tags = {
+        temporary = "true"
+      }
+    }
+
+    expiration {
+      date = "2030-01-01"
+    }
+  }
+}
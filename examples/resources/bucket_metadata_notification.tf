@@ -0,0 +1,13 @@
+resource "storagegrid_bucket" "example_search" {
+  name = "example-bucket-search"
+}
+
+resource "storagegrid_bucket_metadata_notification" "example" {
+  bucket_name = storagegrid_bucket.example_search.name
+
+  rule {
+    id              = "index-documents"
+    prefix          = "documents/"
+    destination_urn = "arn:aws:es:us-east-1:123456789012:domain/example-domain/example-index/_doc"
+  }
+}
@@ -0,0 +1,15 @@
+resource "storagegrid_bucket" "example_notification" {
+  name = "example-bucket-notification"
+}
+
+resource "storagegrid_bucket_notification" "example" {
+  bucket_name = storagegrid_bucket.example_notification.name
+
+  topic {
+    id            = "object-created"
+    topic_urn     = "arn:aws:sns:us-east-1:123456789012:example-topic"
+    events        = ["s3:ObjectCreated:*"]
+    filter_prefix = "incoming/"
+    filter_suffix = ".csv"
+  }
+}
@@ -0,0 +1,25 @@
+resource "storagegrid_bucket" "example_replicated" {
+  name = "example-bucket-replicated"
+}
+
+resource "storagegrid_bucket_versioning" "example_replicated" {
+  bucket_name = storagegrid_bucket.example_replicated.name
+  status      = "Enabled"
+}
+
+resource "storagegrid_bucket_replication" "example" {
+  bucket_name = storagegrid_bucket_versioning.example_replicated.bucket_name
+
+  rule {
+    id              = "replicate-all"
+    destination_urn = "arn:aws:s3:::example-bucket-replica"
+  }
+
+  rule {
+    id              = "replicate-images"
+    status          = "Enabled"
+    prefix          = "images/"
+    destination_urn = "arn:aws:s3:::example-bucket-images"
+    storage_class   = "STANDARD_IA"
+  }
+}
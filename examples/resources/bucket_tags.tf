@@ -0,0 +1,23 @@
+resource "storagegrid_bucket" "example" {
+  name = "example-bucket-tags"
+}
+
+data "storagegrid_user" "example" {
+  unique_name = "user/example-s3-user"
+}
+
+resource "storagegrid_s3_access_key" "example" {
+  user_uuid = data.storagegrid_user.example.id
+}
+
+resource "storagegrid_bucket_tags" "example" {
+  bucket_name       = storagegrid_bucket.example.name
+  s3_endpoint       = "https://s3.example.com:10443"
+  access_key        = storagegrid_s3_access_key.example.access_key
+  secret_access_key = storagegrid_s3_access_key.example.secret_access_key
+
+  tags = {
+    cost-center = "1234"
+    team        = "storage"
+  }
+}
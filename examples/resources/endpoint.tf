@@ -0,0 +1,39 @@
+resource "storagegrid_endpoint" "example_s3" {
+  display_name = "example-replica"
+  endpoint_uri = "https://s3.us-east-1.amazonaws.com"
+  endpoint_urn = "arn:aws:s3:::example-bucket-replica"
+
+  auth = {
+    type                 = "accessKey"
+    access_key_id        = var.replica_access_key_id
+    secret_access_key_wo = var.replica_secret_access_key
+    secrets_wo_version   = 1
+  }
+}
+
+resource "storagegrid_endpoint" "example_search" {
+  display_name = "example-search"
+  endpoint_uri = "https://search.example.com:9200"
+  endpoint_urn = "arn:aws:es:us-east-1:123456789012:domain/example-domain/example-index/_doc"
+
+  auth = {
+    type        = "basicHttp"
+    username    = "elastic"
+    password_wo = var.search_password
+  }
+}
+
+variable "replica_access_key_id" {
+  type      = string
+  sensitive = true
+}
+
+variable "replica_secret_access_key" {
+  type      = string
+  sensitive = true
+}
+
+variable "search_password" {
+  type      = string
+  sensitive = true
+}
@@ -0,0 +1,6 @@
+# The provider must authenticate as grid administrator, i.e. with grid_admin = true
+resource "storagegrid_grid_client_certificate" "prometheus" {
+  display_name     = "external-prometheus"
+  certificate      = file("prometheus-client.crt")
+  allow_prometheus = true
+}
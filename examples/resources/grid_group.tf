@@ -0,0 +1,6 @@
+# The provider must authenticate as grid administrator, i.e. with grid_admin = true
+resource "storagegrid_grid_group" "example" {
+  unique_name  = "group/ilm-admins"
+  display_name = "ILM administrators"
+  permissions  = ["ilm", "metrics-query"]
+}
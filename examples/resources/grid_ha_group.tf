@@ -0,0 +1,18 @@
+# The provider must authenticate as grid administrator, i.e. with grid_admin = true
+resource "storagegrid_grid_ha_group" "example" {
+  name        = "s3-vip"
+  description = "Virtual IP of the S3 endpoint"
+  virtual_ips = ["10.0.0.10"]
+
+  # the first interface is the preferred master
+  interfaces = [
+    {
+      node_id   = "3b6c2a74-xxxxxx"
+      interface = "eth2"
+    },
+    {
+      node_id   = "9f0e41d8-xxxxxx"
+      interface = "eth2"
+    },
+  ]
+}
@@ -0,0 +1,10 @@
+# The provider must authenticate as grid administrator, i.e. with grid_admin = true
+resource "storagegrid_grid_ilm_policy" "example" {
+  name            = "logs-policy"
+  reason          = "Erasure code the logs after 30 days"
+  rule_ids        = [storagegrid_grid_ilm_rule.logs.id]
+  default_rule_id = storagegrid_grid_ilm_rule.default.id
+
+  # replaces the active ILM policy of the grid, the plan shows a warning before
+  activate = true
+}
@@ -0,0 +1,50 @@
+# The provider must authenticate as grid administrator, i.e. with grid_admin = true
+resource "storagegrid_grid_ilm_rule" "logs" {
+  name            = "logs"
+  description     = "Two copies for 30 days, erasure coded afterwards"
+  ingest_behavior = "strict"
+
+  filter = {
+    tenant_ids  = [storagegrid_grid_tenant.example.id]
+    bucket_name = "logs"
+    key_prefix  = "app/"
+  }
+
+  placements = [
+    {
+      start_day     = 0
+      duration_days = 30
+      copies = [
+        {
+          storage_pool_id = storagegrid_grid_storage_pool.example.id
+          count           = 2
+        },
+      ]
+    },
+    {
+      start_day = 30
+      copies = [
+        {
+          erasure_coding_profile_id = "1"
+        },
+      ]
+    },
+  ]
+}
+
+# The default rule of an ILM policy must not have a filter
+resource "storagegrid_grid_ilm_rule" "default" {
+  name = "two-copies"
+
+  placements = [
+    {
+      start_day = 0
+      copies = [
+        {
+          storage_pool_id = storagegrid_grid_storage_pool.example.id
+          count           = 2
+        },
+      ]
+    },
+  ]
+}
@@ -0,0 +1,14 @@
+# The provider must authenticate as grid administrator, i.e. with grid_admin = true
+resource "storagegrid_grid_load_balancer_endpoint" "example" {
+  display_name           = "s3-https"
+  port                   = 10443
+  certificate            = file("s3.example.com.crt")
+  private_key_wo         = file("s3.example.com.key")
+  private_key_wo_version = 1
+
+  # only available on the virtual IP of the HA group
+  binding_mode = "ha-groups"
+  ha_group_ids = [storagegrid_grid_ha_group.example.id]
+
+  blocked_tenant_ids = [storagegrid_grid_tenant.example.id]
+}
@@ -0,0 +1,13 @@
+# The provider must authenticate as grid administrator, i.e. with grid_admin = true
+resource "storagegrid_grid_server_certificate" "s3" {
+  interface   = "s3-swift"
+  certificate = file("s3.example.com.crt")
+  ca_bundle   = file("intermediate-ca.crt")
+
+  # requires Terraform 1.11 or later, bump the version together with a renewed certificate
+  private_key_wo         = file("s3.example.com.key")
+  private_key_wo_version = 1
+
+  # every plan warns from 60 days before the expiry on
+  expiry_warning_days = 60
+}
@@ -0,0 +1,11 @@
+# The provider must authenticate as grid administrator, i.e. with grid_admin = true
+resource "storagegrid_grid_storage_pool" "example" {
+  name = "data-center-1"
+
+  # all storage nodes of the site, regardless of their storage grade
+  criteria = [
+    {
+      site_id = "10"
+    },
+  ]
+}
@@ -0,0 +1,15 @@
+# The provider must authenticate as grid administrator, i.e. with grid_admin = true
+resource "storagegrid_grid_tenant" "example" {
+  name                        = "example"
+  capabilities                = ["management", "s3"]
+  quota_object_bytes          = 10000000000000 # 10 TB
+  use_account_identity_source = true
+  allow_platform_services     = true
+  allow_select_object_content = false
+  root_password_wo            = var.tenant_root_password
+}
+
+variable "tenant_root_password" {
+  type      = string
+  sensitive = true
+}
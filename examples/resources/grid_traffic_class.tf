@@ -0,0 +1,22 @@
+# The provider must authenticate as grid administrator, i.e. with grid_admin = true
+resource "storagegrid_grid_traffic_class" "example" {
+  name        = "noisy-tenant"
+  description = "Throttle the uploads of the batch jobs"
+
+  matchers = [
+    {
+      type    = "tenant"
+      members = [storagegrid_grid_tenant.example.id]
+    },
+    {
+      type    = "bucket"
+      members = [storagegrid_bucket.example.name]
+    },
+  ]
+
+  # bandwidths in bytes per second, request rates in requests per second
+  limits = {
+    aggregate_bandwidth_in = 100000000
+    write_request_rate     = 500
+  }
+}
@@ -0,0 +1,6 @@
+# The provider must authenticate as grid administrator, i.e. with grid_admin = true
+resource "storagegrid_grid_user" "example" {
+  unique_name = "user/jdoe"
+  full_name   = "John Doe"
+  member_of   = [storagegrid_grid_group.example.id]
+}
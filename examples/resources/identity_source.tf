@@ -0,0 +1,37 @@
+resource "storagegrid_identity_source" "example" {
+  type     = "active-directory"
+  hostname = "ad.example.com"
+  port     = 636
+  tls_mode = "ldaps"
+
+  username            = "EXAMPLE\\storagegrid"
+  password_wo         = var.ldap_bind_password
+  password_wo_version = 1
+
+  user_base_dn  = "OU=Users,DC=example,DC=com"
+  group_base_dn = "OU=Groups,DC=example,DC=com"
+}
+
+# federated groups require the identity source
+resource "storagegrid_groups" "example" {
+  unique_name          = "federated-group/storage-admins"
+  display_name         = "Storage administrators"
+  management_read_only = false
+
+  policies = {
+    management = {
+      manage_all_containers        = true
+      manage_endpoints             = true
+      manage_own_container_objects = true
+      manage_own_s3_credentials    = true
+      root_access                  = true
+    }
+  }
+
+  depends_on = [storagegrid_identity_source.example]
+}
+
+variable "ldap_bind_password" {
+  type      = string
+  sensitive = true
+}
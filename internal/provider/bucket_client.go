@@ -11,6 +11,7 @@ import (
 	"net/http"
 	"strconv"
 
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
@@ -27,7 +28,8 @@ type BucketClient struct {
 	apiClient *S3GridClient
 }
 
-// Create creates a new StorageGrid bucket from the given BucketResourceModel configuration.
+// Create creates a new StorageGrid bucket from the given BucketResourceModel configuration. If the bucket is created,
+// but its settings cannot be applied, the created bucket is returned together with the error.
 func (c *BucketClient) Create(ctx context.Context, bucket BucketResourceModel) (*BucketResourceModel, error) {
 	httpResp, _, _, err := c.apiClient.SendRequest("POST", api_buckets, bucket.ToBucketModel(), 201)
 	if err != nil {
@@ -39,13 +41,29 @@ func (c *BucketClient) Create(ctx context.Context, bucket BucketResourceModel) (
 		return nil, fmt.Errorf("unable to unmarshal create StorageGrid container response: %w", err)
 	}
 
+	// last access time updates and the consistency level can only be configured once the bucket exists. If they fail,
+	// the created bucket is still returned with the error, so that Terraform tracks it.
+	var settingsErrs []error
+	if !bucket.LastAccessTimeEnabled.IsNull() && !bucket.LastAccessTimeEnabled.IsUnknown() {
+		if err := c.updateLastAccessTime(ctx, returnBody.Data.Name, bucket.LastAccessTimeEnabled.ValueBool()); err != nil {
+			settingsErrs = append(settingsErrs, err)
+		}
+	}
+
+	if !bucket.ConsistencyLevel.IsNull() && !bucket.ConsistencyLevel.IsUnknown() {
+		if err := c.updateConsistencyLevel(ctx, returnBody.Data.Name, bucket.ConsistencyLevel.ValueString()); err != nil {
+			settingsErrs = append(settingsErrs, err)
+		}
+	}
+
 	// if no region is provided, we need to read the resource to get the used default region.
 	read, err := c.Read(ctx, returnBody.Data.Name)
 	if err != nil {
-		return nil, fmt.Errorf("unable to read newly created StorageGrid container: %w", err)
+		settingsErrs = append(settingsErrs, fmt.Errorf("unable to read newly created StorageGrid container: %w", err))
+		return nil, errors.Join(settingsErrs...)
 	}
 
-	return read, nil
+	return read, errors.Join(settingsErrs...)
 }
 
 // Update updates the StorageGrid bucket with the given BucketResourceModel configuration.
@@ -58,21 +76,31 @@ func (c *BucketClient) Update(ctx context.Context, plan, state BucketResourceMod
 
 	payload := plan.ToBucketModel()
 
-	if payload.S3ObjectLock == nil {
-		return &state, nil
+	if payload.S3ObjectLock != nil {
+		_, _, _, err := c.apiClient.SendRequest("PUT", fmt.Sprintf("%s/%s/object-lock", api_buckets, state.Name.ValueString()), payload.S3ObjectLock, 200)
+		if err != nil {
+			return nil, fmt.Errorf("unable to update StorageGrid container: %w", err)
+		}
+	}
+
+	if !plan.LastAccessTimeEnabled.IsUnknown() && !plan.LastAccessTimeEnabled.IsNull() && !plan.LastAccessTimeEnabled.Equal(state.LastAccessTimeEnabled) {
+		if err := c.updateLastAccessTime(ctx, state.Name.ValueString(), plan.LastAccessTimeEnabled.ValueBool()); err != nil {
+			return nil, err
+		}
 	}
 
-	_, _, _, err := c.apiClient.SendRequest("PUT", fmt.Sprintf("%s/%s/object-lock", api_buckets, state.Name.ValueString()), payload.S3ObjectLock, 200)
-	if err != nil {
-		return nil, fmt.Errorf("unable to update StorageGrid container: %w", err)
+	if !plan.ConsistencyLevel.IsUnknown() && !plan.ConsistencyLevel.IsNull() && !plan.ConsistencyLevel.Equal(state.ConsistencyLevel) {
+		if err := c.updateConsistencyLevel(ctx, state.Name.ValueString(), plan.ConsistencyLevel.ValueString()); err != nil {
+			return nil, err
+		}
 	}
 
-	updatedObjectLockConfiguration, err := c.readObjectLockConfiguration(ctx, state.Name.ValueString())
+	updated, err := c.Read(ctx, state.Name.ValueString())
 	if err != nil {
 		return nil, fmt.Errorf("unable to read updated StorageGrid container: %w", err)
 	}
 
-	return &BucketResourceModel{Name: state.Name, Region: state.Region, ObjectLockConfiguration: updatedObjectLockConfiguration}, nil
+	return updated, nil
 }
 
 // Delete deletes the StorageGrid bucket with the given name.
@@ -93,9 +121,19 @@ func (c *BucketClient) Read(ctx context.Context, bucketName string) (*BucketReso
 		val *ObjectLockConfiguration
 		err error
 	}
+	type latResult struct {
+		val *bool
+		err error
+	}
+	type conResult struct {
+		val *string
+		err error
+	}
 
 	regCh := make(chan regionResult, 1)
 	olcCh := make(chan olcResult, 1)
+	latCh := make(chan latResult, 1)
+	conCh := make(chan conResult, 1)
 
 	go func() {
 		v, err := c.readRegion(ctx, bucketName)
@@ -107,10 +145,22 @@ func (c *BucketClient) Read(ctx context.Context, bucketName string) (*BucketReso
 		olcCh <- olcResult{val: v, err: err}
 	}()
 
+	go func() {
+		v, err := c.readLastAccessTime(ctx, bucketName)
+		latCh <- latResult{val: v, err: err}
+	}()
+
+	go func() {
+		v, err := c.readConsistencyLevel(ctx, bucketName)
+		conCh <- conResult{val: v, err: err}
+	}()
+
 	reg := <-regCh
 	olc := <-olcCh
+	lat := <-latCh
+	con := <-conCh
 
-	combinedErrs := errors.Join(reg.err, olc.err)
+	combinedErrs := errors.Join(reg.err, olc.err, lat.err, con.err)
 	if combinedErrs != nil {
 		return nil, combinedErrs
 	}
@@ -119,6 +169,8 @@ func (c *BucketClient) Read(ctx context.Context, bucketName string) (*BucketReso
 		Name:                    types.StringValue(bucketName),
 		Region:                  types.StringValue(*reg.val),
 		ObjectLockConfiguration: olc.val,
+		LastAccessTimeEnabled:   types.BoolValue(*lat.val),
+		ConsistencyLevel:        types.StringValue(*con.val),
 	}, nil
 }
 
@@ -210,3 +262,179 @@ func (c *BucketClient) readObjectLockConfiguration(ctx context.Context, bucketNa
 
 	return &objectLockConfiguration, nil
 }
+
+// lastAccessTimeModel is used to read and update whether last access time updates are enabled for a bucket.
+type lastAccessTimeModel struct {
+	LastAccessTime string `json:"lastAccessTime"`
+}
+
+func (c *BucketClient) readLastAccessTime(ctx context.Context, bucketName string) (*bool, error) {
+	tflog.Debug(ctx, "Read bucket last access time setting.")
+	endpoint := fmt.Sprintf("%s/%s/last-access-time", api_buckets, bucketName)
+	respBody, _, respCode, err := c.apiClient.SendRequest("GET", endpoint, nil, 200)
+	if err != nil {
+		if respCode == http.StatusNotFound {
+			return nil, ErrBucketNotFound
+		}
+		return nil, fmt.Errorf("unable to read last access time configuration: %w", err)
+	}
+
+	type lastAccessTimeReadModel struct {
+		Data lastAccessTimeModel `json:"data"`
+	}
+
+	var returnBody lastAccessTimeReadModel
+
+	if err := json.Unmarshal(respBody, &returnBody); err != nil {
+		return nil, &GenericError{Summary: "Client Error", Details: "Unable to parse last access time configuration response, got error: " + err.Error()}
+	}
+
+	enabled := returnBody.Data.LastAccessTime == "enabled"
+	return &enabled, nil
+}
+
+func (c *BucketClient) updateLastAccessTime(ctx context.Context, bucketName string, enabled bool) error {
+	tflog.Debug(ctx, "Update last access time configuration.")
+	payload := lastAccessTimeModel{LastAccessTime: "disabled"}
+	if enabled {
+		payload.LastAccessTime = "enabled"
+	}
+
+	endpoint := fmt.Sprintf("%s/%s/last-access-time", api_buckets, bucketName)
+	if _, _, _, err := c.apiClient.SendRequest("PUT", endpoint, payload, 200); err != nil {
+		return fmt.Errorf("unable to update last access time configuration: %w", err)
+	}
+
+	return nil
+}
+
+// consistencyModel is used to read and update the consistency level of a bucket.
+type consistencyModel struct {
+	Consistency string `json:"consistency"`
+}
+
+func (c *BucketClient) readConsistencyLevel(ctx context.Context, bucketName string) (*string, error) {
+	tflog.Debug(ctx, "Read bucket consistency level.")
+	endpoint := fmt.Sprintf("%s/%s/consistency", api_buckets, bucketName)
+	respBody, _, respCode, err := c.apiClient.SendRequest("GET", endpoint, nil, 200)
+	if err != nil {
+		if respCode == http.StatusNotFound {
+			return nil, ErrBucketNotFound
+		}
+		return nil, fmt.Errorf("unable to read consistency level: %w", err)
+	}
+
+	type consistencyReadModel struct {
+		Data consistencyModel `json:"data"`
+	}
+
+	var returnBody consistencyReadModel
+
+	if err := json.Unmarshal(respBody, &returnBody); err != nil {
+		return nil, &GenericError{Summary: "Client Error", Details: "Unable to parse consistency level response, got error: " + err.Error()}
+	}
+
+	return &returnBody.Data.Consistency, nil
+}
+
+func (c *BucketClient) updateConsistencyLevel(ctx context.Context, bucketName string, consistency string) error {
+	tflog.Debug(ctx, "Update consistency level.")
+	endpoint := fmt.Sprintf("%s/%s/consistency", api_buckets, bucketName)
+	if _, _, _, err := c.apiClient.SendRequest("PUT", endpoint, consistencyModel{Consistency: consistency}, 200); err != nil {
+		return fmt.Errorf("unable to update consistency level: %w", err)
+	}
+
+	return nil
+}
+
+// ReadCompliance reads the legacy Compliance settings of the StorageGrid bucket with the given name.
+// It returns nil without an error if the bucket exists, but is not a legacy Compliant bucket.
+func (c *BucketClient) ReadCompliance(ctx context.Context, bucketName string) (*BucketComplianceResourceModel, error) {
+	tflog.Debug(ctx, "1. Get refreshed bucket compliance information.")
+	endpoint := fmt.Sprintf("%s/%s/compliance", api_buckets, bucketName)
+	respBody, _, respCode, err := c.apiClient.SendRequest("GET", endpoint, nil, 200)
+	if err != nil {
+		if respCode == http.StatusNotFound {
+			// the API does not distinguish between missing and non-compliant buckets
+			if _, err := c.readRegion(ctx, bucketName); err != nil {
+				return nil, err
+			}
+			return nil, nil
+		}
+		return nil, fmt.Errorf("unable to read compliance settings: %w", err)
+	}
+
+	return newBucketComplianceResourceModel(bucketName, respBody)
+}
+
+// UpdateCompliance updates the legacy Compliance settings of the StorageGrid bucket from the given
+// BucketComplianceResourceModel. It fails if the bucket is not a legacy Compliant bucket.
+func (c *BucketClient) UpdateCompliance(ctx context.Context, compliance BucketComplianceResourceModel) (*BucketComplianceResourceModel, error) {
+	tflog.Debug(ctx, "Update bucket compliance settings.")
+	bucketName := compliance.BucketName.ValueString()
+	endpoint := fmt.Sprintf("%s/%s/compliance", api_buckets, bucketName)
+	respBody, _, respCode, err := c.apiClient.SendRequest("PUT", endpoint, compliance.ToBucketComplianceModel(), 200)
+	if err != nil {
+		if respCode == http.StatusNotFound {
+			return nil, fmt.Errorf("bucket %s does not exist or is not a legacy Compliant bucket: %w", bucketName, err)
+		}
+		return nil, fmt.Errorf("unable to update compliance settings: %w", err)
+	}
+
+	return newBucketComplianceResourceModel(bucketName, respBody)
+}
+
+// ReadComplianceGlobal reads the grid's global S3 Object Lock settings.
+func (c *BucketClient) ReadComplianceGlobal(ctx context.Context) (*ComplianceGlobalApiModel, error) {
+	tflog.Debug(ctx, "Get global compliance settings.")
+	respBody, _, _, err := c.apiClient.SendRequest("GET", api_compliance, nil, 200)
+	if err != nil {
+		return nil, fmt.Errorf("unable to read global compliance settings: %w", err)
+	}
+
+	type complianceGlobalReadModel struct {
+		Data ComplianceGlobalApiModel `json:"data"`
+	}
+
+	var returnBody complianceGlobalReadModel
+	if err := json.Unmarshal(respBody, &returnBody); err != nil {
+		return nil, &GenericError{Summary: "Client Error", Details: "Unable to parse global compliance settings response, got error: " + err.Error()}
+	}
+
+	return &returnBody.Data, nil
+}
+
+func newBucketComplianceResourceModel(bucketName string, respBody []byte) (*BucketComplianceResourceModel, error) {
+	type complianceReadModel struct {
+		Data BucketComplianceApiModel `json:"data"`
+	}
+
+	var returnBody complianceReadModel
+	if err := json.Unmarshal(respBody, &returnBody); err != nil {
+		return nil, &GenericError{Summary: "Client Error", Details: "Unable to parse compliance settings response, got error: " + err.Error()}
+	}
+
+	return &BucketComplianceResourceModel{
+		BucketName:             types.StringValue(bucketName),
+		AutoDelete:             types.BoolValue(returnBody.Data.AutoDelete),
+		LegalHold:              types.BoolValue(returnBody.Data.LegalHold),
+		RetentionPeriodMinutes: types.Int64Value(returnBody.Data.RetentionPeriodMinutes),
+	}, nil
+}
+
+// warnIfObjectLockEnabled adds a warning to the diagnostics if the grid uses S3 Object Lock, which replaced the legacy
+// Compliance feature.
+func (c *BucketClient) warnIfObjectLockEnabled(ctx context.Context, diags *diag.Diagnostics) {
+	global, err := c.ReadComplianceGlobal(ctx)
+	if err != nil {
+		tflog.Warn(ctx, "Unable to check whether S3 Object Lock is enabled: "+err.Error())
+		return
+	}
+
+	if global.ComplianceEnabled {
+		diags.AddWarning(
+			"Legacy Compliance has been replaced by S3 Object Lock",
+			"S3 Object Lock is enabled for this grid. Legacy Compliant buckets keep working, but new buckets should use the 'object_lock_configuration' of 'storagegrid_bucket' instead.",
+		)
+	}
+}
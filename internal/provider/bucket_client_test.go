@@ -0,0 +1,63 @@
+// Copyright (c) github.com/dmpe
+// SPDX-License-Identifier: MIT
+
+package provider
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+)
+
+// newBucketStandIn returns a stand-in for the bucket API of StorageGRID, which fails to update the last access time.
+func newBucketStandIn(t *testing.T) *httptest.Server {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method + " " + r.URL.Path {
+		case "POST /api/v4/org/containers":
+			w.WriteHeader(http.StatusCreated)
+			_, _ = w.Write([]byte(`{"data": {"name": "test-bucket", "region": "us-east-1"}}`))
+		case "PUT /api/v4/org/containers/test-bucket/last-access-time":
+			w.WriteHeader(http.StatusBadRequest)
+			_, _ = w.Write([]byte(`{"code": 400, "message": {"text": "Bad Request"}}`))
+		case "PUT /api/v4/org/containers/test-bucket/consistency":
+			_, _ = w.Write([]byte(`{"data": {"consistency": "strong-global"}}`))
+		case "GET /api/v4/org/containers/test-bucket/region":
+			_, _ = w.Write([]byte(`{"data": {"region": "us-east-1"}}`))
+		case "GET /api/v4/org/containers/test-bucket/object-lock":
+			_, _ = w.Write([]byte(`{"data": {"enabled": false}}`))
+		case "GET /api/v4/org/containers/test-bucket/last-access-time":
+			_, _ = w.Write([]byte(`{"data": {"lastAccessTime": "disabled"}}`))
+		case "GET /api/v4/org/containers/test-bucket/consistency":
+			_, _ = w.Write([]byte(`{"data": {"consistency": "strong-global"}}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+func TestBucketClient_CreateSettingsFailed(t *testing.T) {
+	server := newBucketStandIn(t)
+	client := NewBucketClient(NewTokenClient(server.URL, "token", false))
+
+	plan := BucketResourceModel{
+		Name:                  types.StringValue("test-bucket"),
+		Region:                types.StringUnknown(),
+		LastAccessTimeEnabled: types.BoolValue(true),
+		ConsistencyLevel:      types.StringValue("strong-global"),
+	}
+
+	// the created bucket is returned with the error, so that Terraform tracks it
+	bucket, err := client.Create(context.Background(), plan)
+	assert.ErrorContains(t, err, "unable to update last access time configuration")
+	if assert.NotNil(t, bucket) {
+		assert.Equal(t, "test-bucket", bucket.Name.ValueString())
+		assert.False(t, bucket.LastAccessTimeEnabled.ValueBool())
+		assert.Equal(t, "strong-global", bucket.ConsistencyLevel.ValueString())
+	}
+}
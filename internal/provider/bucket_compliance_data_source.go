@@ -0,0 +1,113 @@
+// Copyright (c) github.com/dmpe
+// SPDX-License-Identifier: MIT
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider-defined types fully satisfy framework interfaces.
+var (
+	_ datasource.DataSource              = &bucketComplianceDataSource{}
+	_ datasource.DataSourceWithConfigure = &bucketComplianceDataSource{}
+)
+
+// NewBucketComplianceDataSource returns a new data source instance.
+func NewBucketComplianceDataSource() datasource.DataSource {
+	return &bucketComplianceDataSource{}
+}
+
+// bucketComplianceDataSource defines the data source implementation.
+type bucketComplianceDataSource struct {
+	client *BucketClient
+}
+
+func (d *bucketComplianceDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_bucket_compliance"
+}
+
+func (d *bucketComplianceDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `
+Fetch the legacy Compliance settings of the named bucket.
+If the bucket is not a legacy Compliant bucket, all settings will be 'null'.
+`,
+		Attributes: map[string]schema.Attribute{
+			"bucket_name": schema.StringAttribute{
+				Required:    true,
+				Description: "The name of the bucket",
+			},
+			"auto_delete": schema.BoolAttribute{
+				Computed:    true,
+				Description: "Whether objects are deleted automatically when their retention period expires, unless the bucket is under a legal hold.",
+			},
+			"legal_hold": schema.BoolAttribute{
+				Computed:    true,
+				Description: "Whether the bucket is under a legal hold, which prevents the deletion of objects.",
+			},
+			"retention_period_minutes": schema.Int64Attribute{
+				Computed:    true,
+				Description: "The retention period of objects added to the bucket in minutes, starting when the object is ingested into the grid.",
+			},
+		},
+	}
+}
+
+func (d *bucketComplianceDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*S3GridClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	resp.Diagnostics.Append(requireTenantScope(client)...)
+
+	d.client = NewBucketClient(client)
+}
+
+func (d *bucketComplianceDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state BucketComplianceResourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	compliance, err := d.client.ReadCompliance(ctx, state.BucketName.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error Reading StorageGrid container compliance settings", err.Error())
+		return
+	}
+
+	if compliance == nil {
+		compliance = &BucketComplianceResourceModel{
+			BucketName:             state.BucketName,
+			AutoDelete:             types.BoolNull(),
+			LegalHold:              types.BoolNull(),
+			RetentionPeriodMinutes: types.Int64Null(),
+		}
+	} else {
+		d.client.warnIfObjectLockEnabled(ctx, &resp.Diagnostics)
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, compliance)...)
+}
@@ -0,0 +1,184 @@
+// Copyright (c) github.com/dmpe
+// SPDX-License-Identifier: MIT
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+// Ensure provider-defined types fully satisfy framework interfaces.
+var (
+	_ resource.Resource                = &bucketComplianceResource{}
+	_ resource.ResourceWithConfigure   = &bucketComplianceResource{}
+	_ resource.ResourceWithImportState = &bucketComplianceResource{}
+)
+
+// NewBucketComplianceResource returns a new resource instance.
+func NewBucketComplianceResource() resource.Resource {
+	return &bucketComplianceResource{}
+}
+
+type bucketComplianceResource struct {
+	client *BucketClient
+}
+
+func (r *bucketComplianceResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_bucket_compliance"
+}
+
+func (r *bucketComplianceResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `
+Manage the legacy Compliance settings of an existing legacy Compliant bucket.
+
+**Note:**
+- Legacy Compliance has been replaced by S3 Object Lock. New buckets should use the 'object_lock_configuration' of 'storagegrid_bucket' instead.
+- The bucket must already be a legacy Compliant bucket, the API does not allow to turn a bucket into one.
+- The retention period can only be increased.
+- Legacy Compliance cannot be disabled. Removing this resource only removes it from the Terraform state.
+`,
+		Attributes: map[string]schema.Attribute{
+			"bucket_name": schema.StringAttribute{
+				Required:    true,
+				Description: "The name of the bucket",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"auto_delete": schema.BoolAttribute{
+				Required:    true,
+				Description: "Whether objects are deleted automatically when their retention period expires, unless the bucket is under a legal hold.",
+			},
+			"legal_hold": schema.BoolAttribute{
+				Required:    true,
+				Description: "Whether the bucket is under a legal hold, which prevents the deletion of objects.",
+			},
+			"retention_period_minutes": schema.Int64Attribute{
+				Required:    true,
+				Description: "The retention period of objects added to the bucket in minutes, starting when the object is ingested into the grid.",
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+		},
+	}
+}
+
+func (r *bucketComplianceResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*S3GridClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	resp.Diagnostics.Append(requireTenantScope(client)...)
+
+	r.client = NewBucketClient(client)
+}
+
+func (r *bucketComplianceResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan BucketComplianceResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.client.warnIfObjectLockEnabled(ctx, &resp.Diagnostics)
+
+	compliance, err := r.client.UpdateCompliance(ctx, plan)
+	if err != nil {
+		resp.Diagnostics.AddError("Error Updating StorageGrid container compliance settings", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, compliance)...)
+}
+
+func (r *bucketComplianceResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state BucketComplianceResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	compliance, err := r.client.ReadCompliance(ctx, state.BucketName.ValueString())
+	if err != nil {
+		if errors.Is(err, ErrBucketNotFound) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Error Reading StorageGrid container compliance settings", err.Error())
+		return
+	}
+
+	if compliance == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, compliance)...)
+}
+
+func (r *bucketComplianceResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan BucketComplianceResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.client.warnIfObjectLockEnabled(ctx, &resp.Diagnostics)
+
+	compliance, err := r.client.UpdateCompliance(ctx, plan)
+	if err != nil {
+		resp.Diagnostics.AddError("Error Updating StorageGrid container compliance settings", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, compliance)...)
+}
+
+func (r *bucketComplianceResource) Delete(_ context.Context, _ resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// legacy Compliance cannot be disabled, the settings are kept as they are
+	resp.Diagnostics.AddWarning(
+		"Legacy Compliance settings are kept",
+		"Legacy Compliance cannot be disabled for a bucket. The settings have only been removed from the Terraform state.",
+	)
+}
+
+func (r *bucketComplianceResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	compliance, err := r.client.ReadCompliance(ctx, req.ID)
+	if err != nil {
+		resp.Diagnostics.AddError("Error Importing StorageGrid container compliance settings", err.Error())
+		return
+	}
+
+	if compliance == nil {
+		resp.Diagnostics.AddError("Error Importing StorageGrid container compliance settings", fmt.Sprintf("The bucket %s is not a legacy Compliant bucket.", req.ID))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, compliance)...)
+}
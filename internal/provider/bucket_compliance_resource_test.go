@@ -0,0 +1,34 @@
+// Copyright (c) github.com/dmpe
+// SPDX-License-Identifier: MIT
+
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestBucketComplianceResource_NotCompliantBucket(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"storagegrid": providerserver.NewProtocol6WithError(New("test")()),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: `
+resource "storagegrid_bucket_compliance" "test" {
+  bucket_name              = "tf-provider-acc-test-bucket"
+  auto_delete              = false
+  legal_hold               = false
+  retention_period_minutes = 1440
+}
+`,
+				ExpectError: regexp.MustCompile("not a legacy Compliant bucket"),
+			},
+		},
+	})
+}
@@ -0,0 +1,155 @@
+// Copyright (c) github.com/dmpe
+// SPDX-License-Identifier: MIT
+
+package provider
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type BucketCorsResourceModel struct {
+	BucketName types.String          `tfsdk:"bucket_name"`
+	CorsRule   []BucketCorsRuleModel `tfsdk:"cors_rule"`
+}
+
+type BucketCorsRuleModel struct {
+	ID             types.String   `tfsdk:"id"`
+	AllowedOrigins []types.String `tfsdk:"allowed_origins"`
+	AllowedMethods []types.String `tfsdk:"allowed_methods"`
+	AllowedHeaders []types.String `tfsdk:"allowed_headers"`
+	ExposeHeaders  []types.String `tfsdk:"expose_headers"`
+	MaxAgeSeconds  types.Int64    `tfsdk:"max_age_seconds"`
+}
+
+// BucketCorsApiModel is the JSON envelope used by the API. The CORS configuration itself is sent as S3 XML document.
+type BucketCorsApiModel struct {
+	Cors *string `json:"cors"`
+}
+
+type CorsConfigurationXmlModel struct {
+	XMLName  xml.Name           `xml:"CORSConfiguration"`
+	CorsRule []CorsRuleXmlModel `xml:"CORSRule"`
+}
+
+type CorsRuleXmlModel struct {
+	ID             string   `xml:"ID,omitempty"`
+	AllowedOrigins []string `xml:"AllowedOrigin"`
+	AllowedMethods []string `xml:"AllowedMethod"`
+	AllowedHeaders []string `xml:"AllowedHeader,omitempty"`
+	ExposeHeaders  []string `xml:"ExposeHeader,omitempty"`
+	MaxAgeSeconds  *int64   `xml:"MaxAgeSeconds,omitempty"`
+}
+
+func (m *BucketCorsResourceModel) toBucketCorsApiModel() (*BucketCorsApiModel, error) {
+	rules := make([]CorsRuleXmlModel, len(m.CorsRule))
+	for i, rule := range m.CorsRule {
+		rules[i] = CorsRuleXmlModel{
+			ID:             rule.ID.ValueString(),
+			AllowedOrigins: toJson(rule.AllowedOrigins),
+			AllowedMethods: toJson(rule.AllowedMethods),
+			AllowedHeaders: toJson(rule.AllowedHeaders),
+			ExposeHeaders:  toJson(rule.ExposeHeaders),
+			MaxAgeSeconds:  rule.MaxAgeSeconds.ValueInt64Pointer(),
+		}
+	}
+
+	out, err := xml.Marshal(CorsConfigurationXmlModel{CorsRule: rules})
+	if err != nil {
+		return nil, fmt.Errorf("unable to render bucket CORS configuration: %w", err)
+	}
+
+	cors := string(out)
+	return &BucketCorsApiModel{Cors: &cors}, nil
+}
+
+func (m *BucketCorsResourceModel) upsert(client HttpClient) (*BucketCorsResourceModel, error) {
+	endpoint := fmt.Sprintf("%s/%s/cors", api_buckets, m.BucketName.ValueString())
+
+	payload, err := m.toBucketCorsApiModel()
+	if err != nil {
+		return nil, err
+	}
+
+	respBody, _, respCode, err := client.SendRequest("PUT", endpoint, payload, 200)
+	if err != nil {
+		if respCode == http.StatusNotFound {
+			return nil, ErrBucketNotFound
+		}
+		return nil, fmt.Errorf("unable to create or update bucket CORS configuration: %w", err)
+	}
+
+	return NewBucketCorsResourceModel(m.BucketName.ValueString(), respBody)
+}
+
+func (m *BucketCorsResourceModel) read(client HttpClient) (*BucketCorsResourceModel, error) {
+	endpoint := fmt.Sprintf("%s/%s/cors", api_buckets, m.BucketName.ValueString())
+	respBody, _, respCode, err := client.SendRequest("GET", endpoint, nil, 200)
+	if err != nil {
+		if respCode == http.StatusNotFound {
+			return nil, ErrBucketNotFound
+		}
+		return nil, fmt.Errorf("unable to read bucket CORS configuration: %w", err)
+	}
+
+	return NewBucketCorsResourceModel(m.BucketName.ValueString(), respBody)
+}
+
+func (m *BucketCorsResourceModel) delete(client HttpClient) error {
+	endpoint := fmt.Sprintf("%s/%s/cors", api_buckets, m.BucketName.ValueString())
+
+	payload := BucketCorsApiModel{Cors: nil}
+
+	_, _, respCode, err := client.SendRequest("PUT", endpoint, payload, 200)
+	if err != nil {
+		if respCode == http.StatusNotFound {
+			return ErrBucketNotFound
+		}
+		return fmt.Errorf("unable to delete bucket CORS configuration: %w", err)
+	}
+
+	return nil
+}
+
+// NewBucketCorsResourceModel parses the JSON response from the API, including the embedded XML document, into a
+// BucketCorsResourceModel. A bucket without CORS configuration results in a model without any rules.
+func NewBucketCorsResourceModel(bucketName string, input []byte) (*BucketCorsResourceModel, error) {
+	type responseDataType struct {
+		Data BucketCorsApiModel `json:"data"`
+	}
+
+	var returnBody responseDataType
+	if err := json.Unmarshal(input, &returnBody); err != nil {
+		return nil, &GenericError{Summary: "Client Error", Details: "Unable to parse bucket CORS configuration response, got error: " + err.Error()}
+	}
+
+	model := &BucketCorsResourceModel{
+		BucketName: types.StringValue(bucketName),
+	}
+
+	if returnBody.Data.Cors == nil || *returnBody.Data.Cors == "" {
+		return model, nil
+	}
+
+	var corsConfiguration CorsConfigurationXmlModel
+	if err := xml.Unmarshal([]byte(*returnBody.Data.Cors), &corsConfiguration); err != nil {
+		return nil, &GenericError{Summary: "Client Error", Details: "Unable to parse bucket CORS configuration XML, got error: " + err.Error()}
+	}
+
+	for _, rule := range corsConfiguration.CorsRule {
+		model.CorsRule = append(model.CorsRule, BucketCorsRuleModel{
+			ID:             stringOrNull(rule.ID),
+			AllowedOrigins: toTerraformOrNull(rule.AllowedOrigins),
+			AllowedMethods: toTerraformOrNull(rule.AllowedMethods),
+			AllowedHeaders: toTerraformOrNull(rule.AllowedHeaders),
+			ExposeHeaders:  toTerraformOrNull(rule.ExposeHeaders),
+			MaxAgeSeconds:  types.Int64PointerValue(rule.MaxAgeSeconds),
+		})
+	}
+
+	return model, nil
+}
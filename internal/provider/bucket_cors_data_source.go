@@ -0,0 +1,126 @@
+// Copyright (c) github.com/dmpe
+// SPDX-License-Identifier: MIT
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider-defined types fully satisfy framework interfaces.
+var (
+	_ datasource.DataSource              = &bucketCorsDataSource{}
+	_ datasource.DataSourceWithConfigure = &bucketCorsDataSource{}
+)
+
+// NewBucketCorsDataSource returns a new resource instance.
+func NewBucketCorsDataSource() datasource.DataSource {
+	return &bucketCorsDataSource{}
+}
+
+// bucketCorsDataSource defines the data source implementation.
+type bucketCorsDataSource struct {
+	client *S3GridClient
+}
+
+func (d *bucketCorsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_bucket_cors"
+}
+
+func (d *bucketCorsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `
+Fetch the Cross-Origin Resource Sharing (CORS) configuration of the named bucket.
+If CORS is disabled for the bucket, the 'cors_rule' attribute will be 'null'.
+`,
+		Attributes: map[string]schema.Attribute{
+			"bucket_name": schema.StringAttribute{
+				Required:    true,
+				Description: "The name of the bucket",
+			},
+			"cors_rule": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "The CORS rules of the bucket.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Computed:    true,
+							Description: "The unique identifier of the rule.",
+						},
+						"allowed_origins": schema.ListAttribute{
+							ElementType: types.StringType,
+							Computed:    true,
+							Description: "The origins which are allowed to access the bucket.",
+						},
+						"allowed_methods": schema.ListAttribute{
+							ElementType: types.StringType,
+							Computed:    true,
+							Description: "The HTTP methods the origins are allowed to execute.",
+						},
+						"allowed_headers": schema.ListAttribute{
+							ElementType: types.StringType,
+							Computed:    true,
+							Description: "The headers which are allowed in a preflight request through the 'Access-Control-Request-Headers' header.",
+						},
+						"expose_headers": schema.ListAttribute{
+							ElementType: types.StringType,
+							Computed:    true,
+							Description: "The response headers which customers are able to access from their applications.",
+						},
+						"max_age_seconds": schema.Int64Attribute{
+							Computed:    true,
+							Description: "The time in seconds that the browser is allowed to cache the preflight response.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *bucketCorsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*S3GridClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	resp.Diagnostics.Append(requireTenantScope(client)...)
+
+	d.client = client
+}
+
+func (d *bucketCorsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state BucketCorsResourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	read, err := state.read(d.client)
+	if err != nil {
+		resp.Diagnostics.AddError("Error Reading StorageGrid container CORS configuration", err.Error())
+		return
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &read)...)
+}
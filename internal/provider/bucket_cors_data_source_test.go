@@ -0,0 +1,31 @@
+// Copyright (c) github.com/dmpe
+// SPDX-License-Identifier: MIT
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestBucketCorsDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"storagegrid": providerserver.NewProtocol6WithError(New("test")()),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: `data "storagegrid_bucket_cors" "test" { bucket_name = "tf-provider-acc-test-bucket" }`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.storagegrid_bucket_cors.test", "bucket_name", "tf-provider-acc-test-bucket"),
+					resource.TestCheckResourceAttr("data.storagegrid_bucket_cors.test", "cors_rule.#", "1"),
+					resource.TestCheckResourceAttr("data.storagegrid_bucket_cors.test", "cors_rule.0.allowed_origins.0", "*"),
+					resource.TestCheckResourceAttr("data.storagegrid_bucket_cors.test", "cors_rule.0.allowed_methods.0", "GET"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,230 @@
+// Copyright (c) github.com/dmpe
+// SPDX-License-Identifier: MIT
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider-defined types fully satisfy framework interfaces.
+var (
+	_ resource.Resource                = &bucketCorsResource{}
+	_ resource.ResourceWithConfigure   = &bucketCorsResource{}
+	_ resource.ResourceWithImportState = &bucketCorsResource{}
+)
+
+// NewBucketCorsResource returns a new resource instance.
+func NewBucketCorsResource() resource.Resource {
+	return &bucketCorsResource{}
+}
+
+type bucketCorsResource struct {
+	client *S3GridClient
+}
+
+func (r *bucketCorsResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_bucket_cors"
+}
+
+func (r *bucketCorsResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `
+Manage the Cross-Origin Resource Sharing (CORS) configuration of the named bucket, allowing web applications in other domains to access its objects.
+Removing this resource disables CORS for the referenced bucket.
+`,
+		Attributes: map[string]schema.Attribute{
+			"bucket_name": schema.StringAttribute{
+				Required:    true,
+				Description: "The name of the bucket",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"cors_rule": schema.ListNestedBlock{
+				Description: "A CORS rule of the bucket. At least one rule is required, at most 100 rules are allowed.",
+				Validators: []validator.List{
+					listvalidator.IsRequired(),
+					listvalidator.SizeBetween(1, 100),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Optional:    true,
+							Description: "The unique identifier of the rule.",
+							Validators: []validator.String{
+								stringvalidator.LengthBetween(1, 255),
+							},
+						},
+						"allowed_origins": schema.ListAttribute{
+							ElementType: types.StringType,
+							Required:    true,
+							Description: "The origins which are allowed to access the bucket, e.g. 'https://www.example.com' or '*'.",
+							Validators: []validator.List{
+								listvalidator.SizeAtLeast(1),
+							},
+						},
+						"allowed_methods": schema.ListAttribute{
+							ElementType: types.StringType,
+							Required:    true,
+							Description: "The HTTP methods the origins are allowed to execute. Can be 'GET', 'PUT', 'POST', 'DELETE' or 'HEAD'.",
+							Validators: []validator.List{
+								listvalidator.SizeAtLeast(1),
+								listvalidator.ValueStringsAre(stringvalidator.OneOf("GET", "PUT", "POST", "DELETE", "HEAD")),
+							},
+						},
+						"allowed_headers": schema.ListAttribute{
+							ElementType: types.StringType,
+							Optional:    true,
+							Description: "The headers which are allowed in a preflight request through the 'Access-Control-Request-Headers' header.",
+							Validators: []validator.List{
+								listvalidator.SizeAtLeast(1),
+							},
+						},
+						"expose_headers": schema.ListAttribute{
+							ElementType: types.StringType,
+							Optional:    true,
+							Description: "The response headers which customers are able to access from their applications.",
+							Validators: []validator.List{
+								listvalidator.SizeAtLeast(1),
+							},
+						},
+						"max_age_seconds": schema.Int64Attribute{
+							Optional:    true,
+							Description: "The time in seconds that the browser is allowed to cache the preflight response.",
+							Validators: []validator.Int64{
+								int64validator.AtLeast(0),
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *bucketCorsResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*S3GridClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	resp.Diagnostics.Append(requireTenantScope(client)...)
+
+	r.client = client
+}
+
+func (r *bucketCorsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan BucketCorsResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	cors, err := plan.upsert(r.client)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, cors)...)
+}
+
+func (r *bucketCorsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan BucketCorsResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	cors, err := plan.upsert(r.client)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, cors)...)
+}
+
+func (r *bucketCorsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state BucketCorsResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	read, err := state.read(r.client)
+	if err != nil {
+		if errors.Is(err, ErrBucketNotFound) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Error Reading StorageGrid container CORS configuration", err.Error())
+		return
+	}
+
+	// CORS has been disabled outside of Terraform
+	if len(read.CorsRule) == 0 {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, read)...)
+}
+
+func (r *bucketCorsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state BucketCorsResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := state.delete(r.client)
+	if err != nil {
+		resp.Diagnostics.AddError("Error Deleting StorageGrid container CORS configuration", err.Error())
+		return
+	}
+}
+
+func (r *bucketCorsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	model := BucketCorsResourceModel{
+		BucketName: types.StringValue(req.ID),
+	}
+
+	state, err := model.read(r.client)
+	if err != nil {
+		resp.Diagnostics.AddError("Error Importing StorageGrid container CORS configuration", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
@@ -0,0 +1,78 @@
+// Copyright (c) github.com/dmpe
+// SPDX-License-Identifier: MIT
+
+package provider
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestBucketCorsResource(t *testing.T) {
+	bucketName := fmt.Sprintf("tf-provider-acc-test-bucket-cors-%d", time.Now().Unix())
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"storagegrid": providerserver.NewProtocol6WithError(New("test")()),
+		},
+		Steps: []resource.TestStep{
+			// Create
+			{
+				Config: bucketCorsConfiguration(bucketName, "https://www.example.com", 3000),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("storagegrid_bucket_cors.test", "bucket_name", bucketName),
+					resource.TestCheckResourceAttr("storagegrid_bucket_cors.test", "cors_rule.#", "1"),
+					resource.TestCheckResourceAttr("storagegrid_bucket_cors.test", "cors_rule.0.id", "web-app"),
+					resource.TestCheckResourceAttr("storagegrid_bucket_cors.test", "cors_rule.0.allowed_origins.0", "https://www.example.com"),
+					resource.TestCheckResourceAttr("storagegrid_bucket_cors.test", "cors_rule.0.allowed_methods.#", "2"),
+					resource.TestCheckResourceAttr("storagegrid_bucket_cors.test", "cors_rule.0.max_age_seconds", "3000"),
+				),
+			},
+			// Update
+			{
+				Config: bucketCorsConfiguration(bucketName, "*", 600),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("storagegrid_bucket_cors.test", "bucket_name", bucketName),
+					resource.TestCheckResourceAttr("storagegrid_bucket_cors.test", "cors_rule.0.allowed_origins.0", "*"),
+					resource.TestCheckResourceAttr("storagegrid_bucket_cors.test", "cors_rule.0.max_age_seconds", "600"),
+				),
+			},
+			// Import
+			{
+				ResourceName:                         "storagegrid_bucket_cors.test",
+				ImportState:                          true,
+				ImportStateVerifyIdentifierAttribute: "bucket_name",
+				ImportStateId:                        bucketName,
+			},
+			// Delete testing is done automatically
+		},
+	})
+}
+
+func bucketCorsConfiguration(bucketName string, origin string, maxAge int64) string {
+	bucketResource := fmt.Sprintf(`
+resource "storagegrid_bucket" "test" {
+	name = "%s"
+}`, bucketName)
+
+	corsConfiguration := fmt.Sprintf(`
+resource "storagegrid_bucket_cors" "test" {
+	bucket_name = storagegrid_bucket.test.name
+
+	cors_rule {
+		id              = "web-app"
+		allowed_origins = ["%s"]
+		allowed_methods = ["GET", "HEAD"]
+		allowed_headers = ["*"]
+		expose_headers  = ["ETag"]
+		max_age_seconds = %d
+	}
+}`, origin, maxAge)
+
+	return fmt.Sprintf("%s\n\n%s", bucketResource, corsConfiguration)
+}
@@ -0,0 +1,199 @@
+// Copyright (c) github.com/dmpe
+// SPDX-License-Identifier: MIT
+
+package provider
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBucketConfigurationResourceModels_XML(t *testing.T) {
+	tests := []struct {
+		name       string
+		model      any
+		toApiModel func(model any) (any, string, error)
+		parse      func(response []byte) (any, error)
+		expected   string
+	}{
+		{
+			name: "CORS",
+			model: &BucketCorsResourceModel{
+				BucketName: types.StringValue("test-bucket"),
+				CorsRule: []BucketCorsRuleModel{
+					{
+						ID:             types.StringValue("rule-1"),
+						AllowedOrigins: []types.String{types.StringValue("https://www.example.com")},
+						AllowedMethods: []types.String{types.StringValue("GET"), types.StringValue("HEAD")},
+						AllowedHeaders: []types.String{types.StringValue("*")},
+						MaxAgeSeconds:  types.Int64Value(3000),
+					},
+					{
+						ID:             types.StringNull(),
+						AllowedOrigins: []types.String{types.StringValue("*")},
+						AllowedMethods: []types.String{types.StringValue("GET")},
+						ExposeHeaders:  []types.String{types.StringValue("ETag")},
+						MaxAgeSeconds:  types.Int64Null(),
+					},
+				},
+			},
+			toApiModel: func(model any) (any, string, error) {
+				payload, err := model.(*BucketCorsResourceModel).toBucketCorsApiModel()
+				if err != nil {
+					return nil, "", err
+				}
+				return payload, *payload.Cors, nil
+			},
+			parse: func(response []byte) (any, error) {
+				return NewBucketCorsResourceModel("test-bucket", response)
+			},
+			expected: "<CORSConfiguration>" +
+				"<CORSRule><ID>rule-1</ID><AllowedOrigin>https://www.example.com</AllowedOrigin><AllowedMethod>GET</AllowedMethod><AllowedMethod>HEAD</AllowedMethod><AllowedHeader>*</AllowedHeader><MaxAgeSeconds>3000</MaxAgeSeconds></CORSRule>" +
+				"<CORSRule><AllowedOrigin>*</AllowedOrigin><AllowedMethod>GET</AllowedMethod><ExposeHeader>ETag</ExposeHeader></CORSRule>" +
+				"</CORSConfiguration>",
+		},
+		{
+			name: "replication",
+			model: &BucketReplicationResourceModel{
+				BucketName: types.StringValue("test-bucket"),
+				Rule: []BucketReplicationRuleModel{
+					{
+						ID:             types.StringValue("rule-1"),
+						Status:         types.StringValue("Enabled"),
+						Prefix:         types.StringValue(""),
+						DestinationURN: types.StringValue("arn:aws:s3:::test-bucket-replicated"),
+						StorageClass:   types.StringValue("STANDARD"),
+					},
+					{
+						ID:             types.StringValue("rule-2"),
+						Status:         types.StringValue("Disabled"),
+						Prefix:         types.StringValue("images/"),
+						DestinationURN: types.StringValue("arn:aws:s3:::test-bucket-images"),
+						StorageClass:   types.StringNull(),
+					},
+				},
+			},
+			toApiModel: func(model any) (any, string, error) {
+				payload, err := model.(*BucketReplicationResourceModel).toBucketReplicationApiModel()
+				if err != nil {
+					return nil, "", err
+				}
+				return payload, *payload.Replication, nil
+			},
+			parse: func(response []byte) (any, error) {
+				return NewBucketReplicationResourceModel("test-bucket", response)
+			},
+			expected: `<ReplicationConfiguration xmlns="http://s3.amazonaws.com/doc/2006-03-01/">` +
+				"<Rule><ID>rule-1</ID><Status>Enabled</Status><Prefix></Prefix><Destination><Bucket>arn:aws:s3:::test-bucket-replicated</Bucket><StorageClass>STANDARD</StorageClass></Destination></Rule>" +
+				"<Rule><ID>rule-2</ID><Status>Disabled</Status><Prefix>images/</Prefix><Destination><Bucket>arn:aws:s3:::test-bucket-images</Bucket></Destination></Rule>" +
+				"</ReplicationConfiguration>",
+		},
+		{
+			name: "notification",
+			model: &BucketNotificationResourceModel{
+				BucketName: types.StringValue("test-bucket"),
+				Topic: []BucketNotificationTopicModel{
+					{
+						ID:           types.StringValue("object-created"),
+						TopicURN:     types.StringValue("arn:aws:sns:us-east-1:123456789012:my-topic"),
+						Events:       []types.String{types.StringValue("s3:ObjectCreated:*")},
+						FilterPrefix: types.StringValue("images/"),
+						FilterSuffix: types.StringValue(".jpg"),
+					},
+					{
+						ID:           types.StringNull(),
+						TopicURN:     types.StringValue("arn:aws:sns:us-east-1:123456789012:other-topic"),
+						Events:       []types.String{types.StringValue("s3:ObjectRemoved:Delete"), types.StringValue("s3:ObjectRemoved:DeleteMarkerCreated")},
+						FilterPrefix: types.StringNull(),
+						FilterSuffix: types.StringNull(),
+					},
+				},
+			},
+			toApiModel: func(model any) (any, string, error) {
+				payload, err := model.(*BucketNotificationResourceModel).toBucketNotificationApiModel()
+				if err != nil {
+					return nil, "", err
+				}
+				return payload, *payload.Notification, nil
+			},
+			parse: func(response []byte) (any, error) {
+				return NewBucketNotificationResourceModel("test-bucket", response)
+			},
+			expected: `<NotificationConfiguration xmlns="http://s3.amazonaws.com/doc/2006-03-01/">` +
+				"<TopicConfiguration><Id>object-created</Id><Topic>arn:aws:sns:us-east-1:123456789012:my-topic</Topic><Event>s3:ObjectCreated:*</Event>" +
+				"<Filter><S3Key><FilterRule><Name>prefix</Name><Value>images/</Value></FilterRule><FilterRule><Name>suffix</Name><Value>.jpg</Value></FilterRule></S3Key></Filter></TopicConfiguration>" +
+				"<TopicConfiguration><Topic>arn:aws:sns:us-east-1:123456789012:other-topic</Topic><Event>s3:ObjectRemoved:Delete</Event><Event>s3:ObjectRemoved:DeleteMarkerCreated</Event></TopicConfiguration>" +
+				"</NotificationConfiguration>",
+		},
+		{
+			name: "metadata notification",
+			model: &BucketMetadataNotificationResourceModel{
+				BucketName: types.StringValue("test-bucket"),
+				Rule: []BucketMetadataNotificationRuleModel{
+					{
+						ID:             types.StringValue("Rule-1"),
+						Status:         types.StringValue("Enabled"),
+						Prefix:         types.StringValue(""),
+						DestinationURN: types.StringValue("arn:aws:es:us-east-1:3333333:domain/my-domain/my-index/_doc"),
+					},
+				},
+			},
+			toApiModel: func(model any) (any, string, error) {
+				payload, err := model.(*BucketMetadataNotificationResourceModel).toBucketMetadataNotificationApiModel()
+				if err != nil {
+					return nil, "", err
+				}
+				return payload, *payload.MetadataNotification, nil
+			},
+			parse: func(response []byte) (any, error) {
+				return NewBucketMetadataNotificationResourceModel("test-bucket", response)
+			},
+			expected: "<MetadataNotificationConfiguration>" +
+				"<Rule><ID>Rule-1</ID><Status>Enabled</Status><Prefix></Prefix><Destination><Urn>arn:aws:es:us-east-1:3333333:domain/my-domain/my-index/_doc</Urn></Destination></Rule>" +
+				"</MetadataNotificationConfiguration>",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			payload, payloadXml, err := tt.toApiModel(tt.model)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, payloadXml)
+
+			// the API echoes the configuration back, which must result in the same model
+			response, err := json.Marshal(map[string]any{"data": payload})
+			assert.NoError(t, err)
+
+			read, err := tt.parse(response)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.model, read)
+		})
+	}
+}
+
+func TestNewBucketCorsResourceModel(t *testing.T) {
+	t.Run("namespaced XML", func(t *testing.T) {
+		response := `{"data": {"cors": "<CORSConfiguration xmlns=\"http://s3.amazonaws.com/doc/2006-03-01/\"><CORSRule><AllowedOrigin>*</AllowedOrigin><AllowedMethod>GET</AllowedMethod></CORSRule></CORSConfiguration>"}}`
+
+		read, err := NewBucketCorsResourceModel("test-bucket", []byte(response))
+		assert.NoError(t, err)
+		assert.Len(t, read.CorsRule, 1)
+		assert.Equal(t, []types.String{types.StringValue("*")}, read.CorsRule[0].AllowedOrigins)
+		assert.Nil(t, read.CorsRule[0].AllowedHeaders)
+		assert.True(t, read.CorsRule[0].ID.IsNull())
+	})
+
+	t.Run("CORS disabled", func(t *testing.T) {
+		read, err := NewBucketCorsResourceModel("test-bucket", []byte(`{"data": {"cors": null}}`))
+		assert.NoError(t, err)
+		assert.Nil(t, read.CorsRule)
+	})
+
+	t.Run("invalid XML", func(t *testing.T) {
+		_, err := NewBucketCorsResourceModel("test-bucket", []byte(`{"data": {"cors": "<CORSConfiguration>"}}`))
+		assert.Error(t, err)
+	})
+}
@@ -43,6 +43,14 @@ func (d *bucketDataSource) Schema(_ context.Context, _ datasource.SchemaRequest,
 				Computed:    true,
 				Description: "The region of the bucket, defaults to the StorageGRID's default region",
 			},
+			"last_access_time_enabled": schema.BoolAttribute{
+				Computed:    true,
+				Description: "Whether the last access time of objects is updated when they are retrieved",
+			},
+			"consistency_level": schema.StringAttribute{
+				Computed:    true,
+				Description: "The consistency level of the bucket, which affects the availability of objects for client requests",
+			},
 		},
 		Blocks: map[string]schema.Block{
 			"object_lock_configuration": schema.SingleNestedBlock{
@@ -83,6 +91,8 @@ func (d *bucketDataSource) Configure(_ context.Context, req datasource.Configure
 		return
 	}
 
+	resp.Diagnostics.Append(requireTenantScope(client)...)
+
 	d.client = NewBucketClient(client)
 }
 
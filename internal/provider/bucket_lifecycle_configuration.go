@@ -0,0 +1,350 @@
+// Copyright (c) github.com/dmpe
+// SPDX-License-Identifier: MIT
+
+package provider
+
+import (
+	"context"
+	"crypto/md5"
+	"encoding/base64"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type BucketLifecycleConfigurationResourceModel struct {
+	BucketName       types.String               `tfsdk:"bucket_name"`
+	S3Endpoint       types.String               `tfsdk:"s3_endpoint"`
+	Region           types.String               `tfsdk:"region"`
+	AccessKey        types.String               `tfsdk:"access_key"`
+	SecretAccessKey  types.String               `tfsdk:"secret_access_key"`
+	VersioningStatus types.String               `tfsdk:"versioning_status"`
+	Rule             []BucketLifecycleRuleModel `tfsdk:"rule"`
+}
+
+type BucketLifecycleRuleModel struct {
+	ID                          types.String                                     `tfsdk:"id"`
+	Status                      types.String                                     `tfsdk:"status"`
+	Filter                      *BucketLifecycleFilterModel                      `tfsdk:"filter"`
+	Expiration                  *BucketLifecycleExpirationModel                  `tfsdk:"expiration"`
+	NoncurrentVersionExpiration *BucketLifecycleNoncurrentVersionExpirationModel `tfsdk:"noncurrent_version_expiration"`
+}
+
+type BucketLifecycleFilterModel struct {
+	Prefix types.String `tfsdk:"prefix"`
+	Tags   types.Map    `tfsdk:"tags"`
+}
+
+type BucketLifecycleExpirationModel struct {
+	Days types.Int64  `tfsdk:"days"`
+	Date types.String `tfsdk:"date"`
+}
+
+type BucketLifecycleNoncurrentVersionExpirationModel struct {
+	NoncurrentDays types.Int64 `tfsdk:"noncurrent_days"`
+}
+
+type LifecycleConfigurationXmlModel struct {
+	XMLName xml.Name                `xml:"LifecycleConfiguration"`
+	Xmlns   string                  `xml:"xmlns,attr,omitempty"`
+	Rule    []LifecycleRuleXmlModel `xml:"Rule"`
+}
+
+type LifecycleRuleXmlModel struct {
+	ID                          string                                        `xml:"ID,omitempty"`
+	Filter                      LifecycleFilterXmlModel                       `xml:"Filter"`
+	Status                      string                                        `xml:"Status"`
+	Expiration                  *LifecycleExpirationXmlModel                  `xml:"Expiration,omitempty"`
+	NoncurrentVersionExpiration *LifecycleNoncurrentVersionExpirationXmlModel `xml:"NoncurrentVersionExpiration,omitempty"`
+}
+
+// LifecycleFilterXmlModel holds either a single condition, or several conditions combined with And.
+type LifecycleFilterXmlModel struct {
+	Prefix *string                     `xml:"Prefix,omitempty"`
+	Tag    *TagXmlModel                `xml:"Tag,omitempty"`
+	And    *LifecycleFilterAndXmlModel `xml:"And,omitempty"`
+}
+
+type LifecycleFilterAndXmlModel struct {
+	Prefix *string       `xml:"Prefix,omitempty"`
+	Tag    []TagXmlModel `xml:"Tag"`
+}
+
+type LifecycleExpirationXmlModel struct {
+	Days *int64  `xml:"Days,omitempty"`
+	Date *string `xml:"Date,omitempty"`
+}
+
+type LifecycleNoncurrentVersionExpirationXmlModel struct {
+	NoncurrentDays int64 `xml:"NoncurrentDays"`
+}
+
+// s3Client creates the client for the S3 endpoint from the connection settings of the model.
+func (m *BucketLifecycleConfigurationResourceModel) s3Client(insecure bool) *S3DataClient {
+	return NewS3DataClient(
+		m.S3Endpoint.ValueString(),
+		m.Region.ValueString(),
+		m.AccessKey.ValueString(),
+		m.SecretAccessKey.ValueString(),
+		insecure,
+	)
+}
+
+// usesNoncurrentVersions reports whether any rule depends on the bucket being versioned.
+func (m *BucketLifecycleConfigurationResourceModel) usesNoncurrentVersions() bool {
+	for _, rule := range m.Rule {
+		if rule.NoncurrentVersionExpiration != nil {
+			return true
+		}
+	}
+	return false
+}
+
+// usesExpiration reports whether any rule expires current object versions.
+func (m *BucketLifecycleConfigurationResourceModel) usesExpiration() bool {
+	for _, rule := range m.Rule {
+		if rule.Expiration != nil {
+			return true
+		}
+	}
+	return false
+}
+
+// checkVersioning warns about rules which do not match the given versioning status of the bucket.
+func (m *BucketLifecycleConfigurationResourceModel) checkVersioning(status string) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if status == "Disabled" && m.usesNoncurrentVersions() {
+		diags.AddAttributeWarning(
+			path.Root("rule"),
+			"Lifecycle rule requires bucket versioning",
+			fmt.Sprintf("Versioning is disabled for bucket '%s', hence 'noncurrent_version_expiration' has no effect. "+
+				"Enable versioning with 'storagegrid_bucket_versioning' first, or remove the block.", m.BucketName.ValueString()),
+		)
+	}
+
+	if status == "Enabled" && m.usesExpiration() && !m.usesNoncurrentVersions() {
+		diags.AddAttributeWarning(
+			path.Root("rule"),
+			"Noncurrent versions are kept",
+			fmt.Sprintf("Versioning is enabled for bucket '%s'. Expired objects only become noncurrent versions, "+
+				"which are kept until a 'noncurrent_version_expiration' rule removes them.", m.BucketName.ValueString()),
+		)
+	}
+
+	return diags
+}
+
+// toXml renders the filter of a rule. The tags are known when the rule is applied, they may only be unknown while
+// the configuration is validated and planned.
+func (f *BucketLifecycleFilterModel) toXml() (LifecycleFilterXmlModel, error) {
+	if f == nil {
+		return LifecycleFilterXmlModel{}, nil
+	}
+
+	prefix := f.Prefix.ValueStringPointer()
+
+	var tagMap map[string]string
+	if !f.Tags.IsNull() && !f.Tags.IsUnknown() {
+		if diags := f.Tags.ElementsAs(context.Background(), &tagMap, false); diags.HasError() {
+			return LifecycleFilterXmlModel{}, fmt.Errorf("unable to read lifecycle filter tags: %v", diags)
+		}
+	}
+
+	// sort the keys to send a stable document
+	keys := make([]string, 0, len(tagMap))
+	for k := range tagMap {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	tags := make([]TagXmlModel, 0, len(keys))
+	for _, k := range keys {
+		tags = append(tags, TagXmlModel{Key: k, Value: tagMap[k]})
+	}
+
+	switch {
+	case len(tags) == 0:
+		return LifecycleFilterXmlModel{Prefix: prefix}, nil
+	case len(tags) == 1 && prefix == nil:
+		return LifecycleFilterXmlModel{Tag: &tags[0]}, nil
+	default:
+		return LifecycleFilterXmlModel{And: &LifecycleFilterAndXmlModel{Prefix: prefix, Tag: tags}}, nil
+	}
+}
+
+func (m *BucketLifecycleConfigurationResourceModel) toLifecycleXml() ([]byte, error) {
+	rules := make([]LifecycleRuleXmlModel, len(m.Rule))
+	for i, rule := range m.Rule {
+		filter, err := rule.Filter.toXml()
+		if err != nil {
+			return nil, err
+		}
+
+		rules[i] = LifecycleRuleXmlModel{
+			ID:     rule.ID.ValueString(),
+			Filter: filter,
+			Status: rule.Status.ValueString(),
+		}
+
+		if rule.Expiration != nil {
+			rules[i].Expiration = &LifecycleExpirationXmlModel{Days: rule.Expiration.Days.ValueInt64Pointer()}
+			if !rule.Expiration.Date.IsNull() {
+				// S3 expects midnight UTC in ISO 8601 format
+				date := rule.Expiration.Date.ValueString() + "T00:00:00Z"
+				rules[i].Expiration.Date = &date
+			}
+		}
+
+		if rule.NoncurrentVersionExpiration != nil {
+			rules[i].NoncurrentVersionExpiration = &LifecycleNoncurrentVersionExpirationXmlModel{
+				NoncurrentDays: rule.NoncurrentVersionExpiration.NoncurrentDays.ValueInt64(),
+			}
+		}
+	}
+
+	out, err := xml.Marshal(LifecycleConfigurationXmlModel{Xmlns: s3_xmlns, Rule: rules})
+	if err != nil {
+		return nil, fmt.Errorf("unable to render bucket lifecycle configuration: %w", err)
+	}
+
+	return out, nil
+}
+
+func (m *BucketLifecycleConfigurationResourceModel) upsert(client *S3DataClient) (*BucketLifecycleConfigurationResourceModel, error) {
+	payload, err := m.toLifecycleXml()
+	if err != nil {
+		return nil, err
+	}
+
+	// PutBucketLifecycleConfiguration requires the MD5 digest of the body
+	digest := md5.Sum(payload)
+	headers := map[string]string{
+		"Content-MD5":  base64.StdEncoding.EncodeToString(digest[:]),
+		"Content-Type": "application/xml",
+	}
+
+	_, respCode, err := client.SendRequest("PUT", m.BucketName.ValueString(), "lifecycle", headers, payload, 200)
+	if err != nil {
+		if respCode == http.StatusNotFound {
+			return nil, ErrBucketNotFound
+		}
+		return nil, fmt.Errorf("unable to create or update bucket lifecycle configuration: %w", err)
+	}
+
+	return m.read(client)
+}
+
+func (m *BucketLifecycleConfigurationResourceModel) read(client *S3DataClient) (*BucketLifecycleConfigurationResourceModel, error) {
+	respBody, respCode, err := client.SendRequest("GET", m.BucketName.ValueString(), "lifecycle", nil, nil, 200)
+	if err != nil {
+		var s3Err *S3Error
+		// a bucket without lifecycle configuration is answered with NoSuchLifecycleConfiguration
+		if errors.As(err, &s3Err) && s3Err.Code == "NoSuchLifecycleConfiguration" {
+			model := *m
+			model.Rule = nil
+			return &model, nil
+		}
+		if respCode == http.StatusNotFound {
+			return nil, ErrBucketNotFound
+		}
+		return nil, fmt.Errorf("unable to read bucket lifecycle configuration: %w", err)
+	}
+
+	return NewBucketLifecycleConfigurationResourceModel(*m, respBody)
+}
+
+func (m *BucketLifecycleConfigurationResourceModel) delete(client *S3DataClient) error {
+	_, respCode, err := client.SendRequest("DELETE", m.BucketName.ValueString(), "lifecycle", nil, nil, 204)
+	if err != nil {
+		if respCode == http.StatusNotFound {
+			return ErrBucketNotFound
+		}
+		return fmt.Errorf("unable to delete bucket lifecycle configuration: %w", err)
+	}
+
+	return nil
+}
+
+// NewBucketLifecycleConfigurationResourceModel parses the lifecycle configuration XML document returned by the S3
+// endpoint into a BucketLifecycleConfigurationResourceModel. The connection settings are taken from the given model.
+func NewBucketLifecycleConfigurationResourceModel(connection BucketLifecycleConfigurationResourceModel, input []byte) (*BucketLifecycleConfigurationResourceModel, error) {
+	var lifecycleConfiguration LifecycleConfigurationXmlModel
+	if err := xml.Unmarshal(input, &lifecycleConfiguration); err != nil {
+		return nil, &GenericError{Summary: "Client Error", Details: "Unable to parse bucket lifecycle configuration XML, got error: " + err.Error()}
+	}
+
+	model := connection
+	model.Rule = nil
+
+	for _, rule := range lifecycleConfiguration.Rule {
+		ruleModel := BucketLifecycleRuleModel{
+			ID:     stringOrNull(rule.ID),
+			Status: types.StringValue(rule.Status),
+			Filter: newBucketLifecycleFilterModel(rule.Filter),
+		}
+
+		if rule.Expiration != nil {
+			ruleModel.Expiration = &BucketLifecycleExpirationModel{
+				Days: types.Int64PointerValue(rule.Expiration.Days),
+				Date: types.StringNull(),
+			}
+			if rule.Expiration.Date != nil {
+				// only the day is managed, the time is always midnight UTC
+				date, _, _ := strings.Cut(*rule.Expiration.Date, "T")
+				ruleModel.Expiration.Date = types.StringValue(date)
+			}
+		}
+
+		if rule.NoncurrentVersionExpiration != nil {
+			ruleModel.NoncurrentVersionExpiration = &BucketLifecycleNoncurrentVersionExpirationModel{
+				NoncurrentDays: types.Int64Value(rule.NoncurrentVersionExpiration.NoncurrentDays),
+			}
+		}
+
+		model.Rule = append(model.Rule, ruleModel)
+	}
+
+	return &model, nil
+}
+
+// newBucketLifecycleFilterModel returns nil for a filter without any condition, which matches all objects.
+func newBucketLifecycleFilterModel(filter LifecycleFilterXmlModel) *BucketLifecycleFilterModel {
+	prefix := filter.Prefix
+	var tags []TagXmlModel
+
+	if filter.Tag != nil {
+		tags = append(tags, *filter.Tag)
+	}
+	if filter.And != nil {
+		prefix = filter.And.Prefix
+		tags = append(tags, filter.And.Tag...)
+	}
+
+	if prefix != nil && *prefix == "" {
+		prefix = nil
+	}
+
+	if prefix == nil && len(tags) == 0 {
+		return nil
+	}
+
+	model := &BucketLifecycleFilterModel{Prefix: types.StringPointerValue(prefix), Tags: types.MapNull(types.StringType)}
+	if len(tags) > 0 {
+		elements := make(map[string]attr.Value, len(tags))
+		for _, tag := range tags {
+			elements[tag.Key] = types.StringValue(tag.Value)
+		}
+		model.Tags = types.MapValueMust(types.StringType, elements)
+	}
+
+	return model
+}
@@ -0,0 +1,357 @@
+// Copyright (c) github.com/dmpe
+// SPDX-License-Identifier: MIT
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider-defined types fully satisfy framework interfaces.
+var (
+	_ resource.Resource                   = &bucketLifecycleConfigurationResource{}
+	_ resource.ResourceWithConfigure      = &bucketLifecycleConfigurationResource{}
+	_ resource.ResourceWithValidateConfig = &bucketLifecycleConfigurationResource{}
+	_ resource.ResourceWithModifyPlan     = &bucketLifecycleConfigurationResource{}
+)
+
+// NewBucketLifecycleConfigurationResource returns a new resource instance.
+func NewBucketLifecycleConfigurationResource() resource.Resource {
+	return &bucketLifecycleConfigurationResource{}
+}
+
+type bucketLifecycleConfigurationResource struct {
+	client *S3GridClient
+}
+
+func (r *bucketLifecycleConfigurationResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_bucket_lifecycle_configuration"
+}
+
+func (r *bucketLifecycleConfigurationResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `
+Manage the lifecycle configuration of the named bucket, which expires objects and noncurrent object versions.
+Removing this resource deletes the lifecycle configuration of the referenced bucket.
+
+**Note:**
+- The lifecycle configuration is managed through the S3 endpoint of StorageGrid (PutBucketLifecycleConfiguration), hence S3 credentials of the tenant are required, e.g. from 'storagegrid_s3_access_key'.
+- The 'insecure' setting of the provider applies to the S3 endpoint as well.
+- During planning the rules are checked against the versioning status of the bucket, see 'storagegrid_bucket_versioning'. A warning is shown if 'noncurrent_version_expiration' is used for a bucket which has never been versioned, or if current versions of a versioned bucket are expired while noncurrent versions are kept forever.
+- Set 'versioning_status' to the status of 'storagegrid_bucket_versioning' to check the rules against the planned versioning, also if the bucket or its versioning is changed in the same apply. Without it, the current versioning status is read from StorageGrid.
+- Import is not supported, as the S3 credentials cannot be passed on import.
+`,
+		Attributes: map[string]schema.Attribute{
+			"bucket_name": schema.StringAttribute{
+				Required:    true,
+				Description: "The name of the bucket",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"s3_endpoint": schema.StringAttribute{
+				Required:    true,
+				Description: "The URL of the S3 endpoint of StorageGrid, e.g. 'https://s3.example.com:10443'. Buckets are addressed in path-style.",
+			},
+			"region": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "The region used to sign the requests. Defaults to 'us-east-1'.",
+				Default:     stringdefault.StaticString(s3_default_region),
+			},
+			"access_key": schema.StringAttribute{
+				Required:    true,
+				Description: "The S3 access key used to sign the requests.",
+			},
+			"secret_access_key": schema.StringAttribute{
+				Required:    true,
+				Sensitive:   true,
+				Description: "The S3 secret access key used to sign the requests.",
+			},
+			"versioning_status": schema.StringAttribute{
+				Optional:    true,
+				Description: "The versioning status of the bucket, e.g. 'storagegrid_bucket_versioning.example.status', which the rules are checked against during planning. Can be 'Enabled', 'Suspended' or 'Disabled'.",
+				Validators: []validator.String{
+					stringvalidator.OneOf("Enabled", "Suspended", "Disabled"),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"rule": schema.ListNestedBlock{
+				Description: "A lifecycle rule of the bucket. At least one rule is required.",
+				Validators: []validator.List{
+					listvalidator.IsRequired(),
+					listvalidator.SizeBetween(1, 1000),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Required:    true,
+							Description: "The unique identifier of the rule.",
+							Validators: []validator.String{
+								stringvalidator.LengthBetween(1, 255),
+							},
+						},
+						"status": schema.StringAttribute{
+							Optional:    true,
+							Computed:    true,
+							Description: "Whether the rule is applied. Can be 'Enabled' or 'Disabled'. Defaults to 'Enabled'.",
+							Default:     stringdefault.StaticString("Enabled"),
+							Validators: []validator.String{
+								stringvalidator.OneOf("Enabled", "Disabled"),
+							},
+						},
+					},
+					Blocks: map[string]schema.Block{
+						"filter": schema.SingleNestedBlock{
+							Description: "The objects the rule applies to. Without filter the rule applies to all objects of the bucket.",
+							Attributes: map[string]schema.Attribute{
+								"prefix": schema.StringAttribute{
+									Optional:    true,
+									Description: "Only objects whose key starts with this prefix are affected.",
+									Validators: []validator.String{
+										stringvalidator.LengthAtLeast(1),
+									},
+								},
+								"tags": schema.MapAttribute{
+									ElementType: types.StringType,
+									Optional:    true,
+									Description: "Only objects with all of these tags are affected.",
+									Validators: []validator.Map{
+										mapvalidator.SizeAtLeast(1),
+									},
+								},
+							},
+						},
+						"expiration": schema.SingleNestedBlock{
+							Description: "Expire the current version of the objects. Either 'days' or 'date' must be set.",
+							Attributes: map[string]schema.Attribute{
+								"days": schema.Int64Attribute{
+									Optional:    true,
+									Description: "The number of days after creation of an object when it expires.",
+									Validators: []validator.Int64{
+										int64validator.AtLeast(1),
+									},
+								},
+								"date": schema.StringAttribute{
+									Optional:    true,
+									Description: "The date in format 'YYYY-MM-DD' at which the objects expire, at midnight UTC.",
+								},
+							},
+						},
+						"noncurrent_version_expiration": schema.SingleNestedBlock{
+							Description: "Permanently delete noncurrent object versions. Requires a bucket with versioning.",
+							Attributes: map[string]schema.Attribute{
+								"noncurrent_days": schema.Int64Attribute{
+									Optional:    true,
+									Description: "The number of days an object version is noncurrent before it is deleted. Required if the block is present.",
+									Validators: []validator.Int64{
+										int64validator.AtLeast(1),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *bucketLifecycleConfigurationResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*S3GridClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	resp.Diagnostics.Append(requireTenantScope(client)...)
+
+	r.client = client
+}
+
+// ValidateConfig checks the combinations of settings within a rule, which cannot be expressed by the schema.
+func (r *bucketLifecycleConfigurationResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config BucketLifecycleConfigurationResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	for i, rule := range config.Rule {
+		rulePath := path.Root("rule").AtListIndex(i)
+
+		if rule.Expiration == nil && rule.NoncurrentVersionExpiration == nil {
+			resp.Diagnostics.AddAttributeError(rulePath, "Missing lifecycle action",
+				"Every rule requires an 'expiration' or a 'noncurrent_version_expiration' block.")
+		}
+
+		if rule.Filter != nil && rule.Filter.Prefix.IsNull() && rule.Filter.Tags.IsNull() {
+			resp.Diagnostics.AddAttributeError(rulePath.AtName("filter"), "Empty lifecycle filter",
+				"The 'filter' block requires 'prefix' or 'tags'. Remove the block to apply the rule to all objects.")
+		}
+
+		if exp := rule.Expiration; exp != nil && !exp.Days.IsUnknown() && !exp.Date.IsUnknown() {
+			if exp.Days.IsNull() == exp.Date.IsNull() {
+				resp.Diagnostics.AddAttributeError(rulePath.AtName("expiration"), "Invalid expiration",
+					"Exactly one of 'days' or 'date' must be set.")
+			}
+			if !exp.Date.IsNull() {
+				if _, err := time.Parse(time.DateOnly, exp.Date.ValueString()); err != nil {
+					resp.Diagnostics.AddAttributeError(rulePath.AtName("expiration").AtName("date"), "Invalid expiration date",
+						fmt.Sprintf("The date '%s' must be in format 'YYYY-MM-DD'.", exp.Date.ValueString()))
+				}
+			}
+		}
+
+		if nve := rule.NoncurrentVersionExpiration; nve != nil && nve.NoncurrentDays.IsNull() {
+			resp.Diagnostics.AddAttributeError(rulePath.AtName("noncurrent_version_expiration").AtName("noncurrent_days"),
+				"Missing noncurrent days", "'noncurrent_days' is required in the 'noncurrent_version_expiration' block.")
+		}
+	}
+}
+
+func (r *bucketLifecycleConfigurationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan BucketLifecycleConfigurationResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	lifecycle, err := plan.upsert(plan.s3Client(r.client.insecure))
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, lifecycle)...)
+}
+
+func (r *bucketLifecycleConfigurationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan BucketLifecycleConfigurationResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	lifecycle, err := plan.upsert(plan.s3Client(r.client.insecure))
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, lifecycle)...)
+}
+
+func (r *bucketLifecycleConfigurationResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state BucketLifecycleConfigurationResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	read, err := state.read(state.s3Client(r.client.insecure))
+	if err != nil {
+		if errors.Is(err, ErrBucketNotFound) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Error Reading StorageGrid container lifecycle configuration", err.Error())
+		return
+	}
+
+	// lifecycle configuration has been deleted outside of Terraform
+	if len(read.Rule) == 0 {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, read)...)
+}
+
+func (r *bucketLifecycleConfigurationResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state BucketLifecycleConfigurationResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := state.delete(state.s3Client(r.client.insecure))
+	if err != nil && !errors.Is(err, ErrBucketNotFound) {
+		resp.Diagnostics.AddError("Error Deleting StorageGrid container lifecycle configuration", err.Error())
+		return
+	}
+}
+
+// ModifyPlan checks that the rules match the versioning status of the bucket, as managed by storagegrid_bucket_versioning.
+// The planned versioning_status is used if it is set, otherwise the versioning status is read from StorageGrid.
+func (r *bucketLifecycleConfigurationResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// nothing to check if the resource is planned for destruction
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan BucketLifecycleConfigurationResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// an unknown versioning status is checked once it is known, e.g. when the versioning is changed in the same apply
+	if (!plan.usesNoncurrentVersions() && !plan.usesExpiration()) || plan.VersioningStatus.IsUnknown() {
+		return
+	}
+
+	status := plan.VersioningStatus.ValueString()
+	if plan.VersioningStatus.IsNull() {
+		// the versioning status can only be read once the bucket name is known and the provider is configured
+		if plan.BucketName.IsUnknown() || r.client == nil {
+			return
+		}
+
+		versioningModel := BucketVersioningResourceModel{BucketName: plan.BucketName}
+		versioning, err := versioningModel.read(r.client)
+		if err != nil {
+			resp.Diagnostics.AddWarning(
+				"Unable to verify lifecycle rules",
+				"The versioning state of the bucket could not be read, therefore the lifecycle rules were not checked against it. "+
+					"Set 'versioning_status' to check them against the planned versioning status instead: "+err.Error(),
+			)
+			return
+		}
+		status = versioning.Status.ValueString()
+	}
+
+	resp.Diagnostics.Append(plan.checkVersioning(status)...)
+}
@@ -0,0 +1,139 @@
+// Copyright (c) github.com/dmpe
+// SPDX-License-Identifier: MIT
+
+package provider
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestBucketLifecycleConfigurationResource(t *testing.T) {
+	s3Endpoint := os.Getenv("STORAGEGRID_S3_ENDPOINT")
+	if s3Endpoint == "" {
+		t.Skip("STORAGEGRID_S3_ENDPOINT must be set to test bucket lifecycle configurations")
+	}
+
+	bucketName := fmt.Sprintf("tf-provider-acc-test-bucket-lifecycle-%d", time.Now().Unix())
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"storagegrid": providerserver.NewProtocol6WithError(New("test")()),
+		},
+		Steps: []resource.TestStep{
+			// Create
+			{
+				Config: bucketLifecycleConfiguration(bucketName, s3Endpoint, `
+  rule {
+    id = "expire-logs"
+    filter {
+      prefix = "logs/"
+    }
+    expiration {
+      days = 30
+    }
+  }
+`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("storagegrid_bucket_lifecycle_configuration.test", "bucket_name", bucketName),
+					resource.TestCheckResourceAttr("storagegrid_bucket_lifecycle_configuration.test", "rule.#", "1"),
+					resource.TestCheckResourceAttr("storagegrid_bucket_lifecycle_configuration.test", "rule.0.status", "Enabled"),
+					resource.TestCheckResourceAttr("storagegrid_bucket_lifecycle_configuration.test", "rule.0.filter.prefix", "logs/"),
+					resource.TestCheckResourceAttr("storagegrid_bucket_lifecycle_configuration.test", "rule.0.expiration.days", "30"),
+				),
+			},
+			// Update
+			{
+				Config: bucketLifecycleConfiguration(bucketName, s3Endpoint, `
+  rule {
+    id = "expire-tagged"
+    filter {
+      tags = {
+        temporary = "true"
+      }
+    }
+    expiration {
+      date = "2030-01-01"
+    }
+    noncurrent_version_expiration {
+      noncurrent_days = 7
+    }
+  }
+`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("storagegrid_bucket_lifecycle_configuration.test", "rule.#", "1"),
+					resource.TestCheckResourceAttr("storagegrid_bucket_lifecycle_configuration.test", "rule.0.filter.tags.temporary", "true"),
+					resource.TestCheckResourceAttr("storagegrid_bucket_lifecycle_configuration.test", "rule.0.expiration.date", "2030-01-01"),
+					resource.TestCheckResourceAttr("storagegrid_bucket_lifecycle_configuration.test", "rule.0.noncurrent_version_expiration.noncurrent_days", "7"),
+				),
+			},
+		},
+	})
+}
+
+func TestBucketLifecycleConfigurationResource_InvalidRule(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"storagegrid": providerserver.NewProtocol6WithError(New("test")()),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: bucketLifecycleConfiguration("tf-provider-acc-test-bucket", "https://s3.example.com", `
+  rule {
+    id = "no-action"
+  }
+`),
+				ExpectError: regexp.MustCompile("Missing lifecycle action"),
+			},
+			{
+				Config: bucketLifecycleConfiguration("tf-provider-acc-test-bucket", "https://s3.example.com", `
+  rule {
+    id = "both"
+    expiration {
+      days = 1
+      date = "2030-01-01"
+    }
+  }
+`),
+				ExpectError: regexp.MustCompile("Exactly one of 'days' or 'date' must be set"),
+			},
+		},
+	})
+}
+
+func bucketLifecycleConfiguration(bucketName string, s3Endpoint string, rules string) string {
+	return fmt.Sprintf(`
+resource "storagegrid_bucket" "test" {
+  name = "%s"
+}
+
+resource "storagegrid_bucket_versioning" "test" {
+  bucket_name = storagegrid_bucket.test.name
+  status      = "Enabled"
+}
+
+data "storagegrid_user" "test" {
+  unique_name = "user/%s"
+}
+
+resource "storagegrid_s3_access_key" "test" {
+  user_uuid = data.storagegrid_user.test.id
+}
+
+resource "storagegrid_bucket_lifecycle_configuration" "test" {
+  bucket_name       = storagegrid_bucket_versioning.test.bucket_name
+  s3_endpoint       = "%s"
+  access_key        = storagegrid_s3_access_key.test.access_key
+  secret_access_key = storagegrid_s3_access_key.test.secret_access_key
+  versioning_status = storagegrid_bucket_versioning.test.status
+%s
+}
+`, bucketName, os.Getenv("STORAGEGRID_USERNAME"), s3Endpoint, rules)
+}
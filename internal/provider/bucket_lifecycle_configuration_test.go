@@ -0,0 +1,237 @@
+// Copyright (c) github.com/dmpe
+// SPDX-License-Identifier: MIT
+
+package provider
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBucketLifecycleConfigurationResourceModel_XML(t *testing.T) {
+	model := BucketLifecycleConfigurationResourceModel{
+		BucketName: types.StringValue("test-bucket"),
+		Rule: []BucketLifecycleRuleModel{
+			{
+				ID:         types.StringValue("expire-logs"),
+				Status:     types.StringValue("Enabled"),
+				Filter:     &BucketLifecycleFilterModel{Prefix: types.StringValue("logs/"), Tags: types.MapNull(types.StringType)},
+				Expiration: &BucketLifecycleExpirationModel{Days: types.Int64Value(30), Date: types.StringNull()},
+			},
+			{
+				ID:     types.StringValue("expire-tagged"),
+				Status: types.StringValue("Disabled"),
+				Filter: &BucketLifecycleFilterModel{
+					Prefix: types.StringValue("tmp/"),
+					Tags:   types.MapValueMust(types.StringType, map[string]attr.Value{"b": types.StringValue("2"), "a": types.StringValue("1")}),
+				},
+				Expiration: &BucketLifecycleExpirationModel{Days: types.Int64Null(), Date: types.StringValue("2030-01-01")},
+			},
+			{
+				ID:                          types.StringValue("cleanup-versions"),
+				Status:                      types.StringValue("Enabled"),
+				Filter:                      &BucketLifecycleFilterModel{Prefix: types.StringNull(), Tags: types.MapValueMust(types.StringType, map[string]attr.Value{"a": types.StringValue("1")})},
+				NoncurrentVersionExpiration: &BucketLifecycleNoncurrentVersionExpirationModel{NoncurrentDays: types.Int64Value(7)},
+			},
+			{
+				ID:                          types.StringValue("all-objects"),
+				Status:                      types.StringValue("Enabled"),
+				NoncurrentVersionExpiration: &BucketLifecycleNoncurrentVersionExpirationModel{NoncurrentDays: types.Int64Value(1)},
+			},
+		},
+	}
+
+	payload, err := model.toLifecycleXml()
+	assert.NoError(t, err)
+	assert.Equal(t, `<LifecycleConfiguration xmlns="http://s3.amazonaws.com/doc/2006-03-01/">`+
+		`<Rule><ID>expire-logs</ID><Filter><Prefix>logs/</Prefix></Filter><Status>Enabled</Status><Expiration><Days>30</Days></Expiration></Rule>`+
+		`<Rule><ID>expire-tagged</ID><Filter><And><Prefix>tmp/</Prefix><Tag><Key>a</Key><Value>1</Value></Tag><Tag><Key>b</Key><Value>2</Value></Tag></And></Filter><Status>Disabled</Status><Expiration><Date>2030-01-01T00:00:00Z</Date></Expiration></Rule>`+
+		`<Rule><ID>cleanup-versions</ID><Filter><Tag><Key>a</Key><Value>1</Value></Tag></Filter><Status>Enabled</Status><NoncurrentVersionExpiration><NoncurrentDays>7</NoncurrentDays></NoncurrentVersionExpiration></Rule>`+
+		`<Rule><ID>all-objects</ID><Filter></Filter><Status>Enabled</Status><NoncurrentVersionExpiration><NoncurrentDays>1</NoncurrentDays></NoncurrentVersionExpiration></Rule>`+
+		`</LifecycleConfiguration>`, string(payload))
+
+	// the document is parsed back into the same rules
+	parsed, err := NewBucketLifecycleConfigurationResourceModel(BucketLifecycleConfigurationResourceModel{BucketName: model.BucketName}, payload)
+	assert.NoError(t, err)
+	assert.Equal(t, model.Rule, parsed.Rule)
+}
+
+func TestBucketLifecycleConfigurationResource_UnknownTags(t *testing.T) {
+	ctx := context.Background()
+	r := NewBucketLifecycleConfigurationResource()
+
+	var schemaResp resource.SchemaResponse
+	r.Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+
+	// the tags are unknown if they are built from another resource
+	model := BucketLifecycleConfigurationResourceModel{
+		BucketName:      types.StringValue("test-bucket"),
+		S3Endpoint:      types.StringValue("https://s3.example.com"),
+		Region:          types.StringValue(s3_default_region),
+		AccessKey:       types.StringValue("access"),
+		SecretAccessKey: types.StringValue("secret"),
+		Rule: []BucketLifecycleRuleModel{
+			{
+				ID:         types.StringValue("expire-tagged"),
+				Status:     types.StringValue("Enabled"),
+				Filter:     &BucketLifecycleFilterModel{Prefix: types.StringNull(), Tags: types.MapUnknown(types.StringType)},
+				Expiration: &BucketLifecycleExpirationModel{Days: types.Int64Value(30), Date: types.StringNull()},
+			},
+		},
+	}
+
+	plan := tfsdk.Plan{Schema: schemaResp.Schema}
+	assert.False(t, plan.Set(ctx, &model).HasError())
+
+	var validateResp resource.ValidateConfigResponse
+	r.(resource.ResourceWithValidateConfig).ValidateConfig(ctx, resource.ValidateConfigRequest{
+		Config: tfsdk.Config{Schema: schemaResp.Schema, Raw: plan.Raw},
+	}, &validateResp)
+	assert.False(t, validateResp.Diagnostics.HasError(), "%v", validateResp.Diagnostics)
+
+	var planned BucketLifecycleConfigurationResourceModel
+	assert.False(t, plan.Get(ctx, &planned).HasError())
+	assert.True(t, planned.Rule[0].Filter.Tags.IsUnknown())
+	assert.False(t, planned.usesNoncurrentVersions())
+}
+
+func TestBucketLifecycleConfigurationResource_ModifyPlan(t *testing.T) {
+	ctx := context.Background()
+	r := NewBucketLifecycleConfigurationResource()
+
+	var schemaResp resource.SchemaResponse
+	r.Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+
+	tests := []struct {
+		name             string
+		versioningStatus types.String
+		noncurrent       bool
+		warning          string
+	}{
+		{name: "noncurrent versions of an unversioned bucket", versioningStatus: types.StringValue("Disabled"), noncurrent: true, warning: "Lifecycle rule requires bucket versioning"},
+		{name: "expiration of a versioned bucket", versioningStatus: types.StringValue("Enabled"), warning: "Noncurrent versions are kept"},
+		{name: "noncurrent versions of a versioned bucket", versioningStatus: types.StringValue("Enabled"), noncurrent: true},
+		{name: "noncurrent versions of a suspended bucket", versioningStatus: types.StringValue("Suspended"), noncurrent: true},
+		// the versioning of the same apply is checked once it is known
+		{name: "unknown versioning status", versioningStatus: types.StringUnknown(), noncurrent: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			model := BucketLifecycleConfigurationResourceModel{
+				BucketName:       types.StringUnknown(),
+				S3Endpoint:       types.StringValue("https://s3.example.com"),
+				Region:           types.StringValue(s3_default_region),
+				AccessKey:        types.StringValue("access"),
+				SecretAccessKey:  types.StringValue("secret"),
+				VersioningStatus: tt.versioningStatus,
+				Rule: []BucketLifecycleRuleModel{
+					{
+						ID:         types.StringValue("expire"),
+						Status:     types.StringValue("Enabled"),
+						Expiration: &BucketLifecycleExpirationModel{Days: types.Int64Value(30), Date: types.StringNull()},
+					},
+				},
+			}
+			if tt.noncurrent {
+				model.Rule[0].NoncurrentVersionExpiration = &BucketLifecycleNoncurrentVersionExpirationModel{NoncurrentDays: types.Int64Value(7)}
+			}
+
+			plan := tfsdk.Plan{Schema: schemaResp.Schema}
+			assert.False(t, plan.Set(ctx, &model).HasError())
+
+			// the planned versioning status is checked without reading the bucket from StorageGrid
+			resp := resource.ModifyPlanResponse{Plan: plan}
+			r.(resource.ResourceWithModifyPlan).ModifyPlan(ctx, resource.ModifyPlanRequest{Plan: plan}, &resp)
+			assert.False(t, resp.Diagnostics.HasError(), "%v", resp.Diagnostics)
+
+			if tt.warning == "" {
+				assert.Empty(t, resp.Diagnostics.Warnings())
+			} else if assert.Len(t, resp.Diagnostics.Warnings(), 1) {
+				assert.Equal(t, tt.warning, resp.Diagnostics.Warnings()[0].Summary())
+			}
+		})
+	}
+}
+
+func TestNewBucketLifecycleConfigurationResourceModel(t *testing.T) {
+	// S3 may return the date with milliseconds and the legacy prefix within the filter as empty element
+	input := `<LifecycleConfiguration><Rule><ID>r</ID><Filter><Prefix></Prefix></Filter><Status>Enabled</Status>` +
+		`<Expiration><Date>2030-01-01T00:00:00.000Z</Date></Expiration></Rule></LifecycleConfiguration>`
+
+	model, err := NewBucketLifecycleConfigurationResourceModel(BucketLifecycleConfigurationResourceModel{}, []byte(input))
+	assert.NoError(t, err)
+	assert.Len(t, model.Rule, 1)
+	assert.Nil(t, model.Rule[0].Filter)
+	assert.Equal(t, types.StringValue("2030-01-01"), model.Rule[0].Expiration.Date)
+	assert.True(t, model.Rule[0].Expiration.Days.IsNull())
+	assert.Nil(t, model.Rule[0].NoncurrentVersionExpiration)
+}
+
+func TestBucketLifecycleConfigurationResourceModel_Lifecycle(t *testing.T) {
+	var lifecycle []byte
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if _, ok := r.URL.Query()["lifecycle"]; !ok || r.URL.Path != "/test-bucket" {
+			w.WriteHeader(http.StatusNotImplemented)
+			return
+		}
+
+		switch r.Method {
+		case "PUT":
+			if r.Header.Get("Content-MD5") == "" {
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			}
+			lifecycle, _ = io.ReadAll(r.Body)
+		case "GET":
+			if lifecycle == nil {
+				w.WriteHeader(http.StatusNotFound)
+				_, _ = w.Write([]byte(`<Error><Code>NoSuchLifecycleConfiguration</Code><Message>The lifecycle configuration does not exist</Message></Error>`))
+				return
+			}
+			_, _ = w.Write(lifecycle)
+		case "DELETE":
+			lifecycle = nil
+			w.WriteHeader(http.StatusNoContent)
+		}
+	}))
+	defer server.Close()
+
+	model := BucketLifecycleConfigurationResourceModel{
+		BucketName:      types.StringValue("test-bucket"),
+		S3Endpoint:      types.StringValue(server.URL),
+		Region:          types.StringValue(s3_default_region),
+		AccessKey:       types.StringValue("access"),
+		SecretAccessKey: types.StringValue("secret"),
+		Rule: []BucketLifecycleRuleModel{
+			{
+				ID:         types.StringValue("expire-all"),
+				Status:     types.StringValue("Enabled"),
+				Expiration: &BucketLifecycleExpirationModel{Days: types.Int64Value(30), Date: types.StringNull()},
+			},
+		},
+	}
+	client := model.s3Client(false)
+
+	read, err := model.read(client)
+	assert.NoError(t, err)
+	assert.Empty(t, read.Rule)
+
+	created, err := model.upsert(client)
+	assert.NoError(t, err)
+	assert.Equal(t, model, *created)
+
+	assert.NoError(t, model.delete(client))
+	read, err = model.read(client)
+	assert.NoError(t, err)
+	assert.Empty(t, read.Rule)
+}
@@ -0,0 +1,155 @@
+// Copyright (c) github.com/dmpe
+// SPDX-License-Identifier: MIT
+
+package provider
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type BucketMetadataNotificationResourceModel struct {
+	BucketName types.String                          `tfsdk:"bucket_name"`
+	Rule       []BucketMetadataNotificationRuleModel `tfsdk:"rule"`
+}
+
+type BucketMetadataNotificationRuleModel struct {
+	ID             types.String `tfsdk:"id"`
+	Status         types.String `tfsdk:"status"`
+	Prefix         types.String `tfsdk:"prefix"`
+	DestinationURN types.String `tfsdk:"destination_urn"`
+}
+
+// BucketMetadataNotificationApiModel is the JSON envelope used by the API. The metadata notification configuration
+// itself is sent as XML document.
+type BucketMetadataNotificationApiModel struct {
+	MetadataNotification *string `json:"metadataNotification"`
+}
+
+type MetadataNotificationConfigurationXmlModel struct {
+	XMLName xml.Name                           `xml:"MetadataNotificationConfiguration"`
+	Rule    []MetadataNotificationRuleXmlModel `xml:"Rule"`
+}
+
+type MetadataNotificationRuleXmlModel struct {
+	ID          string                                  `xml:"ID"`
+	Status      string                                  `xml:"Status"`
+	Prefix      string                                  `xml:"Prefix"`
+	Destination MetadataNotificationDestinationXmlModel `xml:"Destination"`
+}
+
+type MetadataNotificationDestinationXmlModel struct {
+	Urn string `xml:"Urn"`
+}
+
+func (m *BucketMetadataNotificationResourceModel) toBucketMetadataNotificationApiModel() (*BucketMetadataNotificationApiModel, error) {
+	rules := make([]MetadataNotificationRuleXmlModel, len(m.Rule))
+	for i, rule := range m.Rule {
+		rules[i] = MetadataNotificationRuleXmlModel{
+			ID:     rule.ID.ValueString(),
+			Status: rule.Status.ValueString(),
+			Prefix: rule.Prefix.ValueString(),
+			Destination: MetadataNotificationDestinationXmlModel{
+				Urn: rule.DestinationURN.ValueString(),
+			},
+		}
+	}
+
+	out, err := xml.Marshal(MetadataNotificationConfigurationXmlModel{Rule: rules})
+	if err != nil {
+		return nil, fmt.Errorf("unable to render bucket metadata notification configuration: %w", err)
+	}
+
+	metadataNotification := string(out)
+	return &BucketMetadataNotificationApiModel{MetadataNotification: &metadataNotification}, nil
+}
+
+func (m *BucketMetadataNotificationResourceModel) upsert(client HttpClient) (*BucketMetadataNotificationResourceModel, error) {
+	endpoint := fmt.Sprintf("%s/%s/metadata-notification", api_buckets, m.BucketName.ValueString())
+
+	payload, err := m.toBucketMetadataNotificationApiModel()
+	if err != nil {
+		return nil, err
+	}
+
+	respBody, _, respCode, err := client.SendRequest("PUT", endpoint, payload, 200)
+	if err != nil {
+		if respCode == http.StatusNotFound {
+			return nil, ErrBucketNotFound
+		}
+		return nil, fmt.Errorf("unable to create or update bucket metadata notification configuration: %w", err)
+	}
+
+	return NewBucketMetadataNotificationResourceModel(m.BucketName.ValueString(), respBody)
+}
+
+func (m *BucketMetadataNotificationResourceModel) read(client HttpClient) (*BucketMetadataNotificationResourceModel, error) {
+	endpoint := fmt.Sprintf("%s/%s/metadata-notification", api_buckets, m.BucketName.ValueString())
+	respBody, _, respCode, err := client.SendRequest("GET", endpoint, nil, 200)
+	if err != nil {
+		if respCode == http.StatusNotFound {
+			return nil, ErrBucketNotFound
+		}
+		return nil, fmt.Errorf("unable to read bucket metadata notification configuration: %w", err)
+	}
+
+	return NewBucketMetadataNotificationResourceModel(m.BucketName.ValueString(), respBody)
+}
+
+func (m *BucketMetadataNotificationResourceModel) delete(client HttpClient) error {
+	endpoint := fmt.Sprintf("%s/%s/metadata-notification", api_buckets, m.BucketName.ValueString())
+
+	payload := BucketMetadataNotificationApiModel{MetadataNotification: nil}
+
+	_, _, respCode, err := client.SendRequest("PUT", endpoint, payload, 200)
+	if err != nil {
+		if respCode == http.StatusNotFound {
+			return ErrBucketNotFound
+		}
+		return fmt.Errorf("unable to delete bucket metadata notification configuration: %w", err)
+	}
+
+	return nil
+}
+
+// NewBucketMetadataNotificationResourceModel parses the JSON response from the API, including the embedded XML document,
+// into a BucketMetadataNotificationResourceModel. A bucket without metadata notification configuration results in a
+// model without any rules.
+func NewBucketMetadataNotificationResourceModel(bucketName string, input []byte) (*BucketMetadataNotificationResourceModel, error) {
+	type responseDataType struct {
+		Data BucketMetadataNotificationApiModel `json:"data"`
+	}
+
+	var returnBody responseDataType
+	if err := json.Unmarshal(input, &returnBody); err != nil {
+		return nil, &GenericError{Summary: "Client Error", Details: "Unable to parse bucket metadata notification configuration response, got error: " + err.Error()}
+	}
+
+	model := &BucketMetadataNotificationResourceModel{
+		BucketName: types.StringValue(bucketName),
+	}
+
+	if returnBody.Data.MetadataNotification == nil || *returnBody.Data.MetadataNotification == "" {
+		return model, nil
+	}
+
+	var metadataNotificationConfiguration MetadataNotificationConfigurationXmlModel
+	if err := xml.Unmarshal([]byte(*returnBody.Data.MetadataNotification), &metadataNotificationConfiguration); err != nil {
+		return nil, &GenericError{Summary: "Client Error", Details: "Unable to parse bucket metadata notification configuration XML, got error: " + err.Error()}
+	}
+
+	for _, rule := range metadataNotificationConfiguration.Rule {
+		model.Rule = append(model.Rule, BucketMetadataNotificationRuleModel{
+			ID:             types.StringValue(rule.ID),
+			Status:         types.StringValue(rule.Status),
+			Prefix:         types.StringValue(rule.Prefix),
+			DestinationURN: types.StringValue(rule.Destination.Urn),
+		})
+	}
+
+	return model, nil
+}
@@ -0,0 +1,113 @@
+// Copyright (c) github.com/dmpe
+// SPDX-License-Identifier: MIT
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+)
+
+// Ensure provider-defined types fully satisfy framework interfaces.
+var (
+	_ datasource.DataSource              = &bucketMetadataNotificationDataSource{}
+	_ datasource.DataSourceWithConfigure = &bucketMetadataNotificationDataSource{}
+)
+
+// NewBucketMetadataNotificationDataSource returns a new resource instance.
+func NewBucketMetadataNotificationDataSource() datasource.DataSource {
+	return &bucketMetadataNotificationDataSource{}
+}
+
+// bucketMetadataNotificationDataSource defines the data source implementation.
+type bucketMetadataNotificationDataSource struct {
+	client *S3GridClient
+}
+
+func (d *bucketMetadataNotificationDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_bucket_metadata_notification"
+}
+
+func (d *bucketMetadataNotificationDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `
+Retrieve the metadata notification (search integration) configuration of the named bucket.
+If metadata notifications are disabled for the bucket, the 'rule' attribute will be 'null'.
+`,
+		Attributes: map[string]schema.Attribute{
+			"bucket_name": schema.StringAttribute{
+				Required:    true,
+				Description: "The name of the bucket",
+			},
+			"rule": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "The metadata notification rules of the bucket.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Computed:    true,
+							Description: "The unique identifier of the rule.",
+						},
+						"status": schema.StringAttribute{
+							Computed:    true,
+							Description: "Whether the rule is applied, either 'Enabled' or 'Disabled'.",
+						},
+						"prefix": schema.StringAttribute{
+							Computed:    true,
+							Description: "Only the metadata of objects whose key starts with this prefix is sent.",
+						},
+						"destination_urn": schema.StringAttribute{
+							Computed:    true,
+							Description: "The URN of the platform services endpoint the metadata is sent to.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *bucketMetadataNotificationDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*S3GridClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	resp.Diagnostics.Append(requireTenantScope(client)...)
+
+	d.client = client
+}
+
+func (d *bucketMetadataNotificationDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state BucketMetadataNotificationResourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	read, err := state.read(d.client)
+	if err != nil {
+		resp.Diagnostics.AddError("Error Reading StorageGrid container metadata notification configuration", err.Error())
+		return
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &read)...)
+}
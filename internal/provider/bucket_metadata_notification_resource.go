@@ -0,0 +1,213 @@
+// Copyright (c) github.com/dmpe
+// SPDX-License-Identifier: MIT
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider-defined types fully satisfy framework interfaces.
+var (
+	_ resource.Resource                = &bucketMetadataNotificationResource{}
+	_ resource.ResourceWithConfigure   = &bucketMetadataNotificationResource{}
+	_ resource.ResourceWithImportState = &bucketMetadataNotificationResource{}
+)
+
+// NewBucketMetadataNotificationResource returns a new resource instance.
+func NewBucketMetadataNotificationResource() resource.Resource {
+	return &bucketMetadataNotificationResource{}
+}
+
+type bucketMetadataNotificationResource struct {
+	client *S3GridClient
+}
+
+func (r *bucketMetadataNotificationResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_bucket_metadata_notification"
+}
+
+func (r *bucketMetadataNotificationResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `
+Manage the metadata notification (search integration) configuration of the named bucket, which sends object metadata to an Elasticsearch platform services endpoint.
+Removing this resource disables metadata notifications for the referenced bucket.
+
+**Note:**
+- The tenant must be allowed to use platform services.
+- Every rule's 'destination_urn' must match the URN of an existing platform services endpoint of the tenant.
+`,
+		Attributes: map[string]schema.Attribute{
+			"bucket_name": schema.StringAttribute{
+				Required:    true,
+				Description: "The name of the bucket",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"rule": schema.ListNestedBlock{
+				Description: "A metadata notification rule of the bucket. At least one rule is required.",
+				Validators: []validator.List{
+					listvalidator.IsRequired(),
+					listvalidator.SizeAtLeast(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Required:    true,
+							Description: "The unique identifier of the rule.",
+							Validators: []validator.String{
+								stringvalidator.LengthBetween(1, 255),
+							},
+						},
+						"status": schema.StringAttribute{
+							Optional:    true,
+							Computed:    true,
+							Description: "Whether the rule is applied. Can be 'Enabled' or 'Disabled'. Defaults to 'Enabled'.",
+							Default:     stringdefault.StaticString("Enabled"),
+							Validators: []validator.String{
+								stringvalidator.OneOf("Enabled", "Disabled"),
+							},
+						},
+						"prefix": schema.StringAttribute{
+							Optional:    true,
+							Computed:    true,
+							Description: "Only the metadata of objects whose key starts with this prefix is sent. Defaults to all objects.",
+							Default:     stringdefault.StaticString(""),
+						},
+						"destination_urn": schema.StringAttribute{
+							Required:    true,
+							Description: "The URN of the platform services endpoint the metadata is sent to, e.g. 'arn:aws:es:us-east-1:123456789012:domain/my-domain/my-index/_doc'.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *bucketMetadataNotificationResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*S3GridClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	resp.Diagnostics.Append(requireTenantScope(client)...)
+
+	r.client = client
+}
+
+func (r *bucketMetadataNotificationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan BucketMetadataNotificationResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	metadataNotification, err := plan.upsert(r.client)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, metadataNotification)...)
+}
+
+func (r *bucketMetadataNotificationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan BucketMetadataNotificationResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	metadataNotification, err := plan.upsert(r.client)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, metadataNotification)...)
+}
+
+func (r *bucketMetadataNotificationResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state BucketMetadataNotificationResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	read, err := state.read(r.client)
+	if err != nil {
+		if errors.Is(err, ErrBucketNotFound) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Error Reading StorageGrid container metadata notification configuration", err.Error())
+		return
+	}
+
+	// metadata notifications have been disabled outside of Terraform
+	if len(read.Rule) == 0 {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, read)...)
+}
+
+func (r *bucketMetadataNotificationResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state BucketMetadataNotificationResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := state.delete(r.client)
+	if err != nil {
+		resp.Diagnostics.AddError("Error Deleting StorageGrid container metadata notification configuration", err.Error())
+		return
+	}
+}
+
+func (r *bucketMetadataNotificationResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	model := BucketMetadataNotificationResourceModel{
+		BucketName: types.StringValue(req.ID),
+	}
+
+	state, err := model.read(r.client)
+	if err != nil {
+		resp.Diagnostics.AddError("Error Importing StorageGrid container metadata notification configuration", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
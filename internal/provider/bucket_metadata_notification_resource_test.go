@@ -0,0 +1,75 @@
+// Copyright (c) github.com/dmpe
+// SPDX-License-Identifier: MIT
+
+package provider
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+// The acceptance tests expect a platform services endpoint with the URN
+// 'arn:aws:es:us-east-1:123456789012:domain/tf-provider-acc-test/index/_doc' to exist for the tenant.
+func TestBucketMetadataNotificationResource(t *testing.T) {
+	bucketName := fmt.Sprintf("tf-provider-acc-test-bucket-metadata-notification-%d", time.Now().Unix())
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"storagegrid": providerserver.NewProtocol6WithError(New("test")()),
+		},
+		Steps: []resource.TestStep{
+			// Create
+			{
+				Config: bucketMetadataNotificationConfiguration(bucketName, "Enabled"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("storagegrid_bucket_metadata_notification.test", "bucket_name", bucketName),
+					resource.TestCheckResourceAttr("storagegrid_bucket_metadata_notification.test", "rule.#", "1"),
+					resource.TestCheckResourceAttr("storagegrid_bucket_metadata_notification.test", "rule.0.id", "index-documents"),
+					resource.TestCheckResourceAttr("storagegrid_bucket_metadata_notification.test", "rule.0.status", "Enabled"),
+					resource.TestCheckResourceAttr("storagegrid_bucket_metadata_notification.test", "rule.0.prefix", "documents/"),
+				),
+			},
+			// Update
+			{
+				Config: bucketMetadataNotificationConfiguration(bucketName, "Disabled"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("storagegrid_bucket_metadata_notification.test", "rule.0.status", "Disabled"),
+				),
+			},
+			// Import
+			{
+				ResourceName:                         "storagegrid_bucket_metadata_notification.test",
+				ImportState:                          true,
+				ImportStateVerifyIdentifierAttribute: "bucket_name",
+				ImportStateId:                        bucketName,
+			},
+			// Delete testing is done automatically
+		},
+	})
+}
+
+func bucketMetadataNotificationConfiguration(bucketName string, status string) string {
+	bucketResource := fmt.Sprintf(`
+resource "storagegrid_bucket" "test" {
+	name = "%s"
+}`, bucketName)
+
+	metadataNotificationConfiguration := fmt.Sprintf(`
+resource "storagegrid_bucket_metadata_notification" "test" {
+	bucket_name = storagegrid_bucket.test.name
+
+	rule {
+		id              = "index-documents"
+		status          = "%s"
+		prefix          = "documents/"
+		destination_urn = "arn:aws:es:us-east-1:123456789012:domain/tf-provider-acc-test/index/_doc"
+	}
+}`, status)
+
+	return fmt.Sprintf("%s\n\n%s", bucketResource, metadataNotificationConfiguration)
+}
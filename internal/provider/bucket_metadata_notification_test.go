@@ -0,0 +1,16 @@
+// Copyright (c) github.com/dmpe
+// SPDX-License-Identifier: MIT
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewBucketMetadataNotificationResourceModel_Disabled(t *testing.T) {
+	read, err := NewBucketMetadataNotificationResourceModel("test-bucket", []byte(`{"data": {"metadataNotification": null}}`))
+	assert.NoError(t, err)
+	assert.Nil(t, read.Rule)
+}
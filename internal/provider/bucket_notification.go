@@ -0,0 +1,185 @@
+// Copyright (c) github.com/dmpe
+// SPDX-License-Identifier: MIT
+
+package provider
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type BucketNotificationResourceModel struct {
+	BucketName types.String                   `tfsdk:"bucket_name"`
+	Topic      []BucketNotificationTopicModel `tfsdk:"topic"`
+}
+
+type BucketNotificationTopicModel struct {
+	ID           types.String   `tfsdk:"id"`
+	TopicURN     types.String   `tfsdk:"topic_urn"`
+	Events       []types.String `tfsdk:"events"`
+	FilterPrefix types.String   `tfsdk:"filter_prefix"`
+	FilterSuffix types.String   `tfsdk:"filter_suffix"`
+}
+
+// BucketNotificationApiModel is the JSON envelope used by the API. The notification configuration itself is sent as S3
+// XML document.
+type BucketNotificationApiModel struct {
+	Notification *string `json:"notification"`
+}
+
+type NotificationConfigurationXmlModel struct {
+	XMLName            xml.Name                    `xml:"NotificationConfiguration"`
+	Xmlns              string                      `xml:"xmlns,attr,omitempty"`
+	TopicConfiguration []NotificationTopicXmlModel `xml:"TopicConfiguration"`
+}
+
+type NotificationTopicXmlModel struct {
+	ID     string                      `xml:"Id,omitempty"`
+	Topic  string                      `xml:"Topic"`
+	Event  []string                    `xml:"Event"`
+	Filter *NotificationFilterXmlModel `xml:"Filter,omitempty"`
+}
+
+type NotificationFilterXmlModel struct {
+	FilterRule []NotificationFilterRuleXmlModel `xml:"S3Key>FilterRule"`
+}
+
+type NotificationFilterRuleXmlModel struct {
+	Name  string `xml:"Name"`
+	Value string `xml:"Value"`
+}
+
+func (m *BucketNotificationResourceModel) toBucketNotificationApiModel() (*BucketNotificationApiModel, error) {
+	topics := make([]NotificationTopicXmlModel, len(m.Topic))
+	for i, topic := range m.Topic {
+		topics[i] = NotificationTopicXmlModel{
+			ID:    topic.ID.ValueString(),
+			Topic: topic.TopicURN.ValueString(),
+			Event: toJson(topic.Events),
+		}
+
+		var rules []NotificationFilterRuleXmlModel
+		if topic.FilterPrefix.ValueString() != "" {
+			rules = append(rules, NotificationFilterRuleXmlModel{Name: "prefix", Value: topic.FilterPrefix.ValueString()})
+		}
+		if topic.FilterSuffix.ValueString() != "" {
+			rules = append(rules, NotificationFilterRuleXmlModel{Name: "suffix", Value: topic.FilterSuffix.ValueString()})
+		}
+		if len(rules) > 0 {
+			topics[i].Filter = &NotificationFilterXmlModel{FilterRule: rules}
+		}
+	}
+
+	out, err := xml.Marshal(NotificationConfigurationXmlModel{Xmlns: s3_xmlns, TopicConfiguration: topics})
+	if err != nil {
+		return nil, fmt.Errorf("unable to render bucket notification configuration: %w", err)
+	}
+
+	notification := string(out)
+	return &BucketNotificationApiModel{Notification: &notification}, nil
+}
+
+func (m *BucketNotificationResourceModel) upsert(client HttpClient) (*BucketNotificationResourceModel, error) {
+	endpoint := fmt.Sprintf("%s/%s/notification", api_buckets, m.BucketName.ValueString())
+
+	payload, err := m.toBucketNotificationApiModel()
+	if err != nil {
+		return nil, err
+	}
+
+	respBody, _, respCode, err := client.SendRequest("PUT", endpoint, payload, 200)
+	if err != nil {
+		if respCode == http.StatusNotFound {
+			return nil, ErrBucketNotFound
+		}
+		return nil, fmt.Errorf("unable to create or update bucket notification configuration: %w", err)
+	}
+
+	return NewBucketNotificationResourceModel(m.BucketName.ValueString(), respBody)
+}
+
+func (m *BucketNotificationResourceModel) read(client HttpClient) (*BucketNotificationResourceModel, error) {
+	endpoint := fmt.Sprintf("%s/%s/notification", api_buckets, m.BucketName.ValueString())
+	respBody, _, respCode, err := client.SendRequest("GET", endpoint, nil, 200)
+	if err != nil {
+		if respCode == http.StatusNotFound {
+			return nil, ErrBucketNotFound
+		}
+		return nil, fmt.Errorf("unable to read bucket notification configuration: %w", err)
+	}
+
+	return NewBucketNotificationResourceModel(m.BucketName.ValueString(), respBody)
+}
+
+func (m *BucketNotificationResourceModel) delete(client HttpClient) error {
+	endpoint := fmt.Sprintf("%s/%s/notification", api_buckets, m.BucketName.ValueString())
+
+	payload := BucketNotificationApiModel{Notification: nil}
+
+	_, _, respCode, err := client.SendRequest("PUT", endpoint, payload, 200)
+	if err != nil {
+		if respCode == http.StatusNotFound {
+			return ErrBucketNotFound
+		}
+		return fmt.Errorf("unable to delete bucket notification configuration: %w", err)
+	}
+
+	return nil
+}
+
+// NewBucketNotificationResourceModel parses the JSON response from the API, including the embedded XML document, into a
+// BucketNotificationResourceModel. A bucket without notification configuration results in a model without any topics.
+func NewBucketNotificationResourceModel(bucketName string, input []byte) (*BucketNotificationResourceModel, error) {
+	type responseDataType struct {
+		Data BucketNotificationApiModel `json:"data"`
+	}
+
+	var returnBody responseDataType
+	if err := json.Unmarshal(input, &returnBody); err != nil {
+		return nil, &GenericError{Summary: "Client Error", Details: "Unable to parse bucket notification configuration response, got error: " + err.Error()}
+	}
+
+	model := &BucketNotificationResourceModel{
+		BucketName: types.StringValue(bucketName),
+	}
+
+	if returnBody.Data.Notification == nil || *returnBody.Data.Notification == "" {
+		return model, nil
+	}
+
+	var notificationConfiguration NotificationConfigurationXmlModel
+	if err := xml.Unmarshal([]byte(*returnBody.Data.Notification), &notificationConfiguration); err != nil {
+		return nil, &GenericError{Summary: "Client Error", Details: "Unable to parse bucket notification configuration XML, got error: " + err.Error()}
+	}
+
+	for _, topic := range notificationConfiguration.TopicConfiguration {
+		topicModel := BucketNotificationTopicModel{
+			ID:           stringOrNull(topic.ID),
+			TopicURN:     types.StringValue(topic.Topic),
+			Events:       toTerraform(topic.Event),
+			FilterPrefix: types.StringNull(),
+			FilterSuffix: types.StringNull(),
+		}
+
+		if topic.Filter != nil {
+			for _, rule := range topic.Filter.FilterRule {
+				// S3 treats the names of filter rules case-insensitive
+				switch strings.ToLower(rule.Name) {
+				case "prefix":
+					topicModel.FilterPrefix = stringOrNull(rule.Value)
+				case "suffix":
+					topicModel.FilterSuffix = stringOrNull(rule.Value)
+				}
+			}
+		}
+
+		model.Topic = append(model.Topic, topicModel)
+	}
+
+	return model, nil
+}
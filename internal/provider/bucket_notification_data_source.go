@@ -0,0 +1,119 @@
+// Copyright (c) github.com/dmpe
+// SPDX-License-Identifier: MIT
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider-defined types fully satisfy framework interfaces.
+var (
+	_ datasource.DataSource              = &bucketNotificationDataSource{}
+	_ datasource.DataSourceWithConfigure = &bucketNotificationDataSource{}
+)
+
+// NewBucketNotificationDataSource returns a new resource instance.
+func NewBucketNotificationDataSource() datasource.DataSource {
+	return &bucketNotificationDataSource{}
+}
+
+// bucketNotificationDataSource defines the data source implementation.
+type bucketNotificationDataSource struct {
+	client *S3GridClient
+}
+
+func (d *bucketNotificationDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_bucket_notification"
+}
+
+func (d *bucketNotificationDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `
+Fetch the event notification configuration of the named bucket.
+If event notifications are disabled for the bucket, the 'topic' attribute will be 'null'.
+`,
+		Attributes: map[string]schema.Attribute{
+			"bucket_name": schema.StringAttribute{
+				Required:    true,
+				Description: "The name of the bucket",
+			},
+			"topic": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "The topic configurations of the bucket.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Computed:    true,
+							Description: "The unique identifier of the topic configuration.",
+						},
+						"topic_urn": schema.StringAttribute{
+							Computed:    true,
+							Description: "The URN of the platform services endpoint the events are published to.",
+						},
+						"events": schema.ListAttribute{
+							ElementType: types.StringType,
+							Computed:    true,
+							Description: "The object events which are published.",
+						},
+						"filter_prefix": schema.StringAttribute{
+							Computed:    true,
+							Description: "Only events of objects whose key starts with this prefix are published.",
+						},
+						"filter_suffix": schema.StringAttribute{
+							Computed:    true,
+							Description: "Only events of objects whose key ends with this suffix are published.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *bucketNotificationDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*S3GridClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	resp.Diagnostics.Append(requireTenantScope(client)...)
+
+	d.client = client
+}
+
+func (d *bucketNotificationDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state BucketNotificationResourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	read, err := state.read(d.client)
+	if err != nil {
+		resp.Diagnostics.AddError("Error Reading StorageGrid container notification configuration", err.Error())
+		return
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &read)...)
+}
@@ -0,0 +1,231 @@
+// Copyright (c) github.com/dmpe
+// SPDX-License-Identifier: MIT
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider-defined types fully satisfy framework interfaces.
+var (
+	_ resource.Resource                = &bucketNotificationResource{}
+	_ resource.ResourceWithConfigure   = &bucketNotificationResource{}
+	_ resource.ResourceWithImportState = &bucketNotificationResource{}
+)
+
+// NewBucketNotificationResource returns a new resource instance.
+func NewBucketNotificationResource() resource.Resource {
+	return &bucketNotificationResource{}
+}
+
+type bucketNotificationResource struct {
+	client *S3GridClient
+}
+
+func (r *bucketNotificationResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_bucket_notification"
+}
+
+func (r *bucketNotificationResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `
+Manage the event notification configuration of the named bucket, which publishes object events to platform services endpoints (SNS topics).
+Removing this resource disables event notifications for the referenced bucket.
+
+**Note:**
+- The tenant must be allowed to use platform services.
+- Every topic's 'topic_urn' must match the URN of an existing platform services endpoint of the tenant.
+`,
+		Attributes: map[string]schema.Attribute{
+			"bucket_name": schema.StringAttribute{
+				Required:    true,
+				Description: "The name of the bucket",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"topic": schema.ListNestedBlock{
+				Description: "A topic configuration of the bucket. At least one topic configuration is required.",
+				Validators: []validator.List{
+					listvalidator.IsRequired(),
+					listvalidator.SizeAtLeast(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Optional:    true,
+							Description: "The unique identifier of the topic configuration.",
+							Validators: []validator.String{
+								stringvalidator.LengthBetween(1, 255),
+							},
+						},
+						"topic_urn": schema.StringAttribute{
+							Required:    true,
+							Description: "The URN of the platform services endpoint the events are published to, e.g. 'arn:aws:sns:us-east-1:123456789012:my-topic'.",
+						},
+						"events": schema.ListAttribute{
+							ElementType: types.StringType,
+							Required:    true,
+							Description: "The object events which are published, e.g. 's3:ObjectCreated:*' or 's3:ObjectRemoved:Delete'.",
+							Validators: []validator.List{
+								listvalidator.SizeAtLeast(1),
+								listvalidator.UniqueValues(),
+								listvalidator.ValueStringsAre(stringvalidator.OneOf(
+									"s3:ObjectCreated:*",
+									"s3:ObjectCreated:Put",
+									"s3:ObjectCreated:Post",
+									"s3:ObjectCreated:Copy",
+									"s3:ObjectCreated:CompleteMultipartUpload",
+									"s3:ObjectRemoved:*",
+									"s3:ObjectRemoved:Delete",
+									"s3:ObjectRemoved:DeleteMarkerCreated",
+									"s3:ObjectRestore:Post",
+								)),
+							},
+						},
+						"filter_prefix": schema.StringAttribute{
+							Optional:    true,
+							Description: "Only events of objects whose key starts with this prefix are published.",
+							Validators: []validator.String{
+								stringvalidator.LengthAtLeast(1),
+							},
+						},
+						"filter_suffix": schema.StringAttribute{
+							Optional:    true,
+							Description: "Only events of objects whose key ends with this suffix are published.",
+							Validators: []validator.String{
+								stringvalidator.LengthAtLeast(1),
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *bucketNotificationResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*S3GridClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	resp.Diagnostics.Append(requireTenantScope(client)...)
+
+	r.client = client
+}
+
+func (r *bucketNotificationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan BucketNotificationResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	notification, err := plan.upsert(r.client)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, notification)...)
+}
+
+func (r *bucketNotificationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan BucketNotificationResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	notification, err := plan.upsert(r.client)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, notification)...)
+}
+
+func (r *bucketNotificationResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state BucketNotificationResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	read, err := state.read(r.client)
+	if err != nil {
+		if errors.Is(err, ErrBucketNotFound) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Error Reading StorageGrid container notification configuration", err.Error())
+		return
+	}
+
+	// notifications have been disabled outside of Terraform
+	if len(read.Topic) == 0 {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, read)...)
+}
+
+func (r *bucketNotificationResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state BucketNotificationResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := state.delete(r.client)
+	if err != nil {
+		resp.Diagnostics.AddError("Error Deleting StorageGrid container notification configuration", err.Error())
+		return
+	}
+}
+
+func (r *bucketNotificationResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	model := BucketNotificationResourceModel{
+		BucketName: types.StringValue(req.ID),
+	}
+
+	state, err := model.read(r.client)
+	if err != nil {
+		resp.Diagnostics.AddError("Error Importing StorageGrid container notification configuration", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
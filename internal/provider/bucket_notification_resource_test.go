@@ -0,0 +1,78 @@
+// Copyright (c) github.com/dmpe
+// SPDX-License-Identifier: MIT
+
+package provider
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+// The acceptance tests expect a platform services endpoint with the URN
+// 'arn:aws:sns:us-east-1:123456789012:tf-provider-acc-test-topic' to exist for the tenant.
+func TestBucketNotificationResource(t *testing.T) {
+	bucketName := fmt.Sprintf("tf-provider-acc-test-bucket-notification-%d", time.Now().Unix())
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"storagegrid": providerserver.NewProtocol6WithError(New("test")()),
+		},
+		Steps: []resource.TestStep{
+			// Create
+			{
+				Config: bucketNotificationConfiguration(bucketName, "s3:ObjectCreated:*"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("storagegrid_bucket_notification.test", "bucket_name", bucketName),
+					resource.TestCheckResourceAttr("storagegrid_bucket_notification.test", "topic.#", "1"),
+					resource.TestCheckResourceAttr("storagegrid_bucket_notification.test", "topic.0.id", "object-created"),
+					resource.TestCheckResourceAttr("storagegrid_bucket_notification.test", "topic.0.events.#", "1"),
+					resource.TestCheckResourceAttr("storagegrid_bucket_notification.test", "topic.0.events.0", "s3:ObjectCreated:*"),
+					resource.TestCheckResourceAttr("storagegrid_bucket_notification.test", "topic.0.filter_prefix", "incoming/"),
+					resource.TestCheckResourceAttr("storagegrid_bucket_notification.test", "topic.0.filter_suffix", ".csv"),
+				),
+			},
+			// Update
+			{
+				Config: bucketNotificationConfiguration(bucketName, "s3:ObjectCreated:Put"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("storagegrid_bucket_notification.test", "topic.0.events.0", "s3:ObjectCreated:Put"),
+				),
+			},
+			// Import
+			{
+				ResourceName:                         "storagegrid_bucket_notification.test",
+				ImportState:                          true,
+				ImportStateVerifyIdentifierAttribute: "bucket_name",
+				ImportStateId:                        bucketName,
+			},
+			// Delete testing is done automatically
+		},
+	})
+}
+
+func bucketNotificationConfiguration(bucketName string, event string) string {
+	bucketResource := fmt.Sprintf(`
+resource "storagegrid_bucket" "test" {
+	name = "%s"
+}`, bucketName)
+
+	notificationConfiguration := fmt.Sprintf(`
+resource "storagegrid_bucket_notification" "test" {
+	bucket_name = storagegrid_bucket.test.name
+
+	topic {
+		id            = "object-created"
+		topic_urn     = "arn:aws:sns:us-east-1:123456789012:tf-provider-acc-test-topic"
+		events        = ["%s"]
+		filter_prefix = "incoming/"
+		filter_suffix = ".csv"
+	}
+}`, event)
+
+	return fmt.Sprintf("%s\n\n%s", bucketResource, notificationConfiguration)
+}
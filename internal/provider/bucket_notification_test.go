@@ -0,0 +1,46 @@
+// Copyright (c) github.com/dmpe
+// SPDX-License-Identifier: MIT
+
+package provider
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewBucketNotificationResourceModel_FilterRuleNames(t *testing.T) {
+	notification := `<?xml version="1.0" encoding="UTF-8"?>
+<NotificationConfiguration xmlns="http://s3.amazonaws.com/doc/2006-03-01/">
+  <TopicConfiguration>
+    <Id>MyEventForPut</Id>
+    <Topic>arn:aws:sns:us-east-1:050340950352:my-topic</Topic>
+    <Event>s3:ObjectCreated:Put</Event>
+    <Filter>
+      <S3Key>
+        <FilterRule>
+          <Name>Prefix</Name>
+          <Value>images/</Value>
+        </FilterRule>
+      </S3Key>
+    </Filter>
+  </TopicConfiguration>
+</NotificationConfiguration>`
+
+	response, err := json.Marshal(map[string]any{"data": map[string]any{"notification": notification}})
+	assert.NoError(t, err)
+
+	read, err := NewBucketNotificationResourceModel("test-bucket", response)
+	assert.NoError(t, err)
+	assert.Len(t, read.Topic, 1)
+	assert.Equal(t, types.StringValue("images/"), read.Topic[0].FilterPrefix)
+	assert.Equal(t, types.StringNull(), read.Topic[0].FilterSuffix)
+}
+
+func TestNewBucketNotificationResourceModel_Disabled(t *testing.T) {
+	read, err := NewBucketNotificationResourceModel("test-bucket", []byte(`{"data": {"notification": null}}`))
+	assert.NoError(t, err)
+	assert.Nil(t, read.Topic)
+}
@@ -51,6 +51,22 @@ func toTerraform(in []string) []types.String {
 	return out
 }
 
+// toTerraformOrNull works like toTerraform, but maps an empty input to a null list instead of an empty one.
+func toTerraformOrNull(in []string) []types.String {
+	if len(in) == 0 {
+		return nil
+	}
+	return toTerraform(in)
+}
+
+// stringOrNull maps an empty string to a null value.
+func stringOrNull(in string) types.String {
+	if in == "" {
+		return types.StringNull()
+	}
+	return types.StringValue(in)
+}
+
 func mapOfMapsToTerraform(in map[string]map[string]string, diagnostics *diag.Diagnostics) types.Map {
 	if in == nil {
 		return types.MapNull(types.MapType{}.WithElementType(types.StringType))
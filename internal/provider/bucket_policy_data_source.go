@@ -154,6 +154,8 @@ func (d *bucketPolicyDataSource) Configure(_ context.Context, req datasource.Con
 		return
 	}
 
+	resp.Diagnostics.Append(requireTenantScope(client)...)
+
 	d.client = client
 }
 
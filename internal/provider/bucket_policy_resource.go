@@ -233,6 +233,8 @@ func (r *bucketPolicyResource) Configure(_ context.Context, req resource.Configu
 		return
 	}
 
+	resp.Diagnostics.Append(requireTenantScope(client)...)
+
 	r.client = client
 }
 
@@ -67,6 +67,8 @@ func (d *bucketQuotaDataSource) Configure(_ context.Context, req datasource.Conf
 		return
 	}
 
+	resp.Diagnostics.Append(requireTenantScope(client)...)
+
 	d.client = client
 }
 
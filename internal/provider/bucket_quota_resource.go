@@ -79,6 +79,8 @@ func (r *bucketQuotaResource) Configure(_ context.Context, req resource.Configur
 		return
 	}
 
+	resp.Diagnostics.Append(requireTenantScope(client)...)
+
 	r.client = client
 }
 
@@ -0,0 +1,159 @@
+// Copyright (c) github.com/dmpe
+// SPDX-License-Identifier: MIT
+
+package provider
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type BucketReplicationResourceModel struct {
+	BucketName types.String                 `tfsdk:"bucket_name"`
+	Rule       []BucketReplicationRuleModel `tfsdk:"rule"`
+}
+
+type BucketReplicationRuleModel struct {
+	ID             types.String `tfsdk:"id"`
+	Status         types.String `tfsdk:"status"`
+	Prefix         types.String `tfsdk:"prefix"`
+	DestinationURN types.String `tfsdk:"destination_urn"`
+	StorageClass   types.String `tfsdk:"storage_class"`
+}
+
+// BucketReplicationApiModel is the JSON envelope used by the API. The replication configuration itself is sent as S3
+// XML document.
+type BucketReplicationApiModel struct {
+	Replication *string `json:"replication"`
+}
+
+type ReplicationConfigurationXmlModel struct {
+	XMLName xml.Name                  `xml:"ReplicationConfiguration"`
+	Xmlns   string                    `xml:"xmlns,attr,omitempty"`
+	Rule    []ReplicationRuleXmlModel `xml:"Rule"`
+}
+
+type ReplicationRuleXmlModel struct {
+	ID          string                         `xml:"ID"`
+	Status      string                         `xml:"Status"`
+	Prefix      string                         `xml:"Prefix"`
+	Destination ReplicationDestinationXmlModel `xml:"Destination"`
+}
+
+type ReplicationDestinationXmlModel struct {
+	Bucket       string `xml:"Bucket"`
+	StorageClass string `xml:"StorageClass,omitempty"`
+}
+
+func (m *BucketReplicationResourceModel) toBucketReplicationApiModel() (*BucketReplicationApiModel, error) {
+	rules := make([]ReplicationRuleXmlModel, len(m.Rule))
+	for i, rule := range m.Rule {
+		rules[i] = ReplicationRuleXmlModel{
+			ID:     rule.ID.ValueString(),
+			Status: rule.Status.ValueString(),
+			Prefix: rule.Prefix.ValueString(),
+			Destination: ReplicationDestinationXmlModel{
+				Bucket:       rule.DestinationURN.ValueString(),
+				StorageClass: rule.StorageClass.ValueString(),
+			},
+		}
+	}
+
+	out, err := xml.Marshal(ReplicationConfigurationXmlModel{Xmlns: s3_xmlns, Rule: rules})
+	if err != nil {
+		return nil, fmt.Errorf("unable to render bucket replication configuration: %w", err)
+	}
+
+	replication := string(out)
+	return &BucketReplicationApiModel{Replication: &replication}, nil
+}
+
+func (m *BucketReplicationResourceModel) upsert(client HttpClient) (*BucketReplicationResourceModel, error) {
+	endpoint := fmt.Sprintf("%s/%s/replication", api_buckets, m.BucketName.ValueString())
+
+	payload, err := m.toBucketReplicationApiModel()
+	if err != nil {
+		return nil, err
+	}
+
+	respBody, _, respCode, err := client.SendRequest("PUT", endpoint, payload, 200)
+	if err != nil {
+		if respCode == http.StatusNotFound {
+			return nil, ErrBucketNotFound
+		}
+		return nil, fmt.Errorf("unable to create or update bucket replication configuration: %w", err)
+	}
+
+	return NewBucketReplicationResourceModel(m.BucketName.ValueString(), respBody)
+}
+
+func (m *BucketReplicationResourceModel) read(client HttpClient) (*BucketReplicationResourceModel, error) {
+	endpoint := fmt.Sprintf("%s/%s/replication", api_buckets, m.BucketName.ValueString())
+	respBody, _, respCode, err := client.SendRequest("GET", endpoint, nil, 200)
+	if err != nil {
+		if respCode == http.StatusNotFound {
+			return nil, ErrBucketNotFound
+		}
+		return nil, fmt.Errorf("unable to read bucket replication configuration: %w", err)
+	}
+
+	return NewBucketReplicationResourceModel(m.BucketName.ValueString(), respBody)
+}
+
+func (m *BucketReplicationResourceModel) delete(client HttpClient) error {
+	endpoint := fmt.Sprintf("%s/%s/replication", api_buckets, m.BucketName.ValueString())
+
+	payload := BucketReplicationApiModel{Replication: nil}
+
+	_, _, respCode, err := client.SendRequest("PUT", endpoint, payload, 200)
+	if err != nil {
+		if respCode == http.StatusNotFound {
+			return ErrBucketNotFound
+		}
+		return fmt.Errorf("unable to delete bucket replication configuration: %w", err)
+	}
+
+	return nil
+}
+
+// NewBucketReplicationResourceModel parses the JSON response from the API, including the embedded XML document, into a
+// BucketReplicationResourceModel. A bucket without replication configuration results in a model without any rules.
+func NewBucketReplicationResourceModel(bucketName string, input []byte) (*BucketReplicationResourceModel, error) {
+	type responseDataType struct {
+		Data BucketReplicationApiModel `json:"data"`
+	}
+
+	var returnBody responseDataType
+	if err := json.Unmarshal(input, &returnBody); err != nil {
+		return nil, &GenericError{Summary: "Client Error", Details: "Unable to parse bucket replication configuration response, got error: " + err.Error()}
+	}
+
+	model := &BucketReplicationResourceModel{
+		BucketName: types.StringValue(bucketName),
+	}
+
+	if returnBody.Data.Replication == nil || *returnBody.Data.Replication == "" {
+		return model, nil
+	}
+
+	var replicationConfiguration ReplicationConfigurationXmlModel
+	if err := xml.Unmarshal([]byte(*returnBody.Data.Replication), &replicationConfiguration); err != nil {
+		return nil, &GenericError{Summary: "Client Error", Details: "Unable to parse bucket replication configuration XML, got error: " + err.Error()}
+	}
+
+	for _, rule := range replicationConfiguration.Rule {
+		model.Rule = append(model.Rule, BucketReplicationRuleModel{
+			ID:             types.StringValue(rule.ID),
+			Status:         types.StringValue(rule.Status),
+			Prefix:         types.StringValue(rule.Prefix),
+			DestinationURN: types.StringValue(rule.Destination.Bucket),
+			StorageClass:   stringOrNull(rule.Destination.StorageClass),
+		})
+	}
+
+	return model, nil
+}
@@ -0,0 +1,271 @@
+// Copyright (c) github.com/dmpe
+// SPDX-License-Identifier: MIT
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider-defined types fully satisfy framework interfaces.
+var (
+	_ resource.Resource                = &bucketReplicationResource{}
+	_ resource.ResourceWithConfigure   = &bucketReplicationResource{}
+	_ resource.ResourceWithImportState = &bucketReplicationResource{}
+	_ resource.ResourceWithModifyPlan  = &bucketReplicationResource{}
+)
+
+// NewBucketReplicationResource returns a new resource instance.
+func NewBucketReplicationResource() resource.Resource {
+	return &bucketReplicationResource{}
+}
+
+type bucketReplicationResource struct {
+	client *S3GridClient
+}
+
+func (r *bucketReplicationResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_bucket_replication"
+}
+
+func (r *bucketReplicationResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `
+Manage the CloudMirror replication configuration of the named bucket, which replicates objects to a platform services endpoint.
+Removing this resource disables replication for the referenced bucket.
+
+**Note:**
+- The tenant must be allowed to use platform services.
+- Every rule's 'destination_urn' must match the URN of a platform services endpoint of the tenant, which exists when the replication is applied. Planning warns about destinations without an endpoint yet, e.g. endpoints created in the same apply.
+`,
+		Attributes: map[string]schema.Attribute{
+			"bucket_name": schema.StringAttribute{
+				Required:    true,
+				Description: "The name of the bucket",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"rule": schema.ListNestedBlock{
+				Description: "A replication rule of the bucket. At least one rule is required.",
+				Validators: []validator.List{
+					listvalidator.IsRequired(),
+					listvalidator.SizeAtLeast(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Required:    true,
+							Description: "The unique identifier of the rule.",
+							Validators: []validator.String{
+								stringvalidator.LengthBetween(1, 255),
+							},
+						},
+						"status": schema.StringAttribute{
+							Optional:    true,
+							Computed:    true,
+							Description: "Whether the rule is applied. Can be 'Enabled' or 'Disabled'. Defaults to 'Enabled'.",
+							Default:     stringdefault.StaticString("Enabled"),
+							Validators: []validator.String{
+								stringvalidator.OneOf("Enabled", "Disabled"),
+							},
+						},
+						"prefix": schema.StringAttribute{
+							Optional:    true,
+							Computed:    true,
+							Description: "Only objects whose key starts with this prefix are replicated. Defaults to all objects.",
+							Default:     stringdefault.StaticString(""),
+						},
+						"destination_urn": schema.StringAttribute{
+							Required:    true,
+							Description: "The URN of the platform services endpoint the objects are replicated to, e.g. 'arn:aws:s3:::my-bucket-replicated'.",
+						},
+						"storage_class": schema.StringAttribute{
+							Optional:    true,
+							Description: "The storage class of the object replicas. Can be 'STANDARD', 'STANDARD_IA' or 'REDUCED_REDUNDANCY'.",
+							Validators: []validator.String{
+								stringvalidator.OneOf("STANDARD", "STANDARD_IA", "REDUCED_REDUNDANCY"),
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *bucketReplicationResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*S3GridClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	resp.Diagnostics.Append(requireTenantScope(client)...)
+
+	r.client = client
+}
+
+func (r *bucketReplicationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan BucketReplicationResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	replication, err := plan.upsert(r.client)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, replication)...)
+}
+
+func (r *bucketReplicationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan BucketReplicationResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	replication, err := plan.upsert(r.client)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, replication)...)
+}
+
+func (r *bucketReplicationResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state BucketReplicationResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	read, err := state.read(r.client)
+	if err != nil {
+		if errors.Is(err, ErrBucketNotFound) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Error Reading StorageGrid container replication configuration", err.Error())
+		return
+	}
+
+	// replication has been disabled outside of Terraform
+	if len(read.Rule) == 0 {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, read)...)
+}
+
+func (r *bucketReplicationResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state BucketReplicationResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := state.delete(r.client)
+	if err != nil {
+		resp.Diagnostics.AddError("Error Deleting StorageGrid container replication configuration", err.Error())
+		return
+	}
+}
+
+func (r *bucketReplicationResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	model := BucketReplicationResourceModel{
+		BucketName: types.StringValue(req.ID),
+	}
+
+	state, err := model.read(r.client)
+	if err != nil {
+		resp.Diagnostics.AddError("Error Importing StorageGrid container replication configuration", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// ModifyPlan warns if a replication rule points to a platform services endpoint which does not exist for the tenant.
+func (r *bucketReplicationResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// nothing to check if the resource is planned for destruction or the provider is not configured yet
+	if req.Plan.Raw.IsNull() || r.client == nil {
+		return
+	}
+
+	var plan BucketReplicationResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var knownURNs bool
+	for _, rule := range plan.Rule {
+		if !rule.DestinationURN.IsUnknown() {
+			knownURNs = true
+		}
+	}
+	if !knownURNs {
+		return
+	}
+
+	urns, err := readEndpointURNs(r.client)
+	if err != nil {
+		resp.Diagnostics.AddWarning(
+			"Unable to verify replication destinations",
+			"The platform services endpoints could not be read, therefore the destinations of the replication rules were not checked: "+err.Error(),
+		)
+		return
+	}
+
+	for i, rule := range plan.Rule {
+		if rule.DestinationURN.IsUnknown() {
+			continue
+		}
+		if !slices.Contains(urns, rule.DestinationURN.ValueString()) {
+			// the endpoint may be created in the same apply, StorageGRID rejects an unknown destination anyway
+			resp.Diagnostics.AddAttributeWarning(
+				path.Root("rule").AtListIndex(i).AtName("destination_urn"),
+				"Unknown replication destination",
+				fmt.Sprintf("No platform services endpoint with URN '%s' exists for this tenant yet. "+
+					"The replication fails unless the endpoint is created before it, e.g. in the same apply.", rule.DestinationURN.ValueString()),
+			)
+		}
+	}
+}
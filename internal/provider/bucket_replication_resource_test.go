@@ -0,0 +1,86 @@
+// Copyright (c) github.com/dmpe
+// SPDX-License-Identifier: MIT
+
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+// The acceptance tests expect a platform services endpoint with the URN 'arn:aws:s3:::tf-provider-acc-test-replica'
+// to exist for the tenant.
+func TestBucketReplicationResource(t *testing.T) {
+	bucketName := fmt.Sprintf("tf-provider-acc-test-bucket-replication-%d", time.Now().Unix())
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"storagegrid": providerserver.NewProtocol6WithError(New("test")()),
+		},
+		Steps: []resource.TestStep{
+			// Unknown destination
+			{
+				Config:      bucketReplicationConfiguration(bucketName, "Enabled", "arn:aws:s3:::tf-provider-acc-test-does-not-exist"),
+				ExpectError: regexp.MustCompile("unable to create or update bucket replication configuration"),
+			},
+			// Create
+			{
+				Config: bucketReplicationConfiguration(bucketName, "Enabled", "arn:aws:s3:::tf-provider-acc-test-replica"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("storagegrid_bucket_replication.test", "bucket_name", bucketName),
+					resource.TestCheckResourceAttr("storagegrid_bucket_replication.test", "rule.#", "1"),
+					resource.TestCheckResourceAttr("storagegrid_bucket_replication.test", "rule.0.id", "replicate-all"),
+					resource.TestCheckResourceAttr("storagegrid_bucket_replication.test", "rule.0.status", "Enabled"),
+					resource.TestCheckResourceAttr("storagegrid_bucket_replication.test", "rule.0.prefix", ""),
+					resource.TestCheckResourceAttr("storagegrid_bucket_replication.test", "rule.0.destination_urn", "arn:aws:s3:::tf-provider-acc-test-replica"),
+				),
+			},
+			// Update
+			{
+				Config: bucketReplicationConfiguration(bucketName, "Disabled", "arn:aws:s3:::tf-provider-acc-test-replica"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("storagegrid_bucket_replication.test", "rule.0.status", "Disabled"),
+				),
+			},
+			// Import
+			{
+				ResourceName:                         "storagegrid_bucket_replication.test",
+				ImportState:                          true,
+				ImportStateVerifyIdentifierAttribute: "bucket_name",
+				ImportStateId:                        bucketName,
+			},
+			// Delete testing is done automatically
+		},
+	})
+}
+
+func bucketReplicationConfiguration(bucketName string, status string, destination string) string {
+	bucketResource := fmt.Sprintf(`
+resource "storagegrid_bucket" "test" {
+	name = "%s"
+}
+
+resource "storagegrid_bucket_versioning" "test" {
+	bucket_name = storagegrid_bucket.test.name
+	status      = "Enabled"
+}`, bucketName)
+
+	replicationConfiguration := fmt.Sprintf(`
+resource "storagegrid_bucket_replication" "test" {
+	bucket_name = storagegrid_bucket_versioning.test.bucket_name
+
+	rule {
+		id              = "replicate-all"
+		status          = "%s"
+		destination_urn = "%s"
+	}
+}`, status, destination)
+
+	return fmt.Sprintf("%s\n\n%s", bucketResource, replicationConfiguration)
+}
@@ -0,0 +1,27 @@
+// Copyright (c) github.com/dmpe
+// SPDX-License-Identifier: MIT
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewBucketReplicationResourceModel_Disabled(t *testing.T) {
+	read, err := NewBucketReplicationResourceModel("test-bucket", []byte(`{"data": {"replication": null}}`))
+	assert.NoError(t, err)
+	assert.Nil(t, read.Rule)
+}
+
+func TestReadEndpointURNs(t *testing.T) {
+	client := &stubHttpClient{responses: []stubResponse{
+		{body: `{"data": [{"id": "1", "endpointURN": "arn:aws:s3:::bucket-a"}, {"id": "2", "endpointURN": "arn:aws:sns:us-east-1:123:topic"}]}`, code: 200},
+	}}
+
+	urns, err := readEndpointURNs(client)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"arn:aws:s3:::bucket-a", "arn:aws:sns:us-east-1:123:topic"}, urns)
+	assert.Equal(t, []string{"GET /org/endpoints?limit=500"}, client.requests)
+}
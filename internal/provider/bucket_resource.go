@@ -13,6 +13,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
@@ -59,6 +60,25 @@ func (r *bucketResource) Schema(_ context.Context, _ resource.SchemaRequest, res
 				},
 				Description: "The region of the bucket, defaults to the StorageGRID's default region",
 			},
+			"last_access_time_enabled": schema.BoolAttribute{
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+				Description: "Whether the last access time of objects is updated when they are retrieved, which is required by ILM rules based on the last access time. Defaults to StorageGRID's setting for new buckets (disabled)",
+			},
+			"consistency_level": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				Validators: []validator.String{
+					stringvalidator.OneOf("all", "strong-global", "strong-site", "read-after-new-write", "available"),
+				},
+				Description: "The consistency level of the bucket, which affects the availability of objects for client requests. Can be 'all', 'strong-global', 'strong-site', 'read-after-new-write' or 'available'. Defaults to StorageGRID's setting for new buckets (read-after-new-write)",
+			},
 		},
 		Blocks: map[string]schema.Block{
 			"object_lock_configuration": schema.SingleNestedBlock{
@@ -115,6 +135,8 @@ func (r *bucketResource) Configure(_ context.Context, req resource.ConfigureRequ
 		return
 	}
 
+	resp.Diagnostics.Append(requireTenantScope(client)...)
+
 	r.client = NewBucketClient(client)
 }
 
@@ -128,13 +150,15 @@ func (r *bucketResource) Create(ctx context.Context, req resource.CreateRequest,
 	}
 
 	read, err := r.client.Create(ctx, plan)
+
+	// Save data into Terraform state, also if only the settings of the created bucket failed
+	if read != nil {
+		resp.Diagnostics.Append(resp.State.Set(ctx, read)...)
+	}
+
 	if err != nil {
 		resp.Diagnostics.AddError("Error Creating StorageGrid container", err.Error())
-		return
 	}
-
-	// Save data into Terraform state
-	resp.Diagnostics.Append(resp.State.Set(ctx, read)...)
 }
 
 func (r *bucketResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -161,7 +185,8 @@ func (r *bucketResource) Read(ctx context.Context, req resource.ReadRequest, res
 
 func (r *bucketResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	// This is a noop for changes of the bucket's name and region as a resource re-creation is enforced.
-	// Therefore, this update case is completely ignored, and we just deal with modifications of the object lock configuration.
+	// Therefore, this update case is completely ignored, and we just deal with modifications of the object lock
+	// configuration, the last access time updates and the consistency level.
 
 	var plan BucketResourceModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
@@ -15,6 +15,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 )
 
 const (
@@ -35,6 +36,8 @@ func TestBucketResource_DefaultRegion(t *testing.T) {
 				Check: resource.ComposeAggregateTestCheckFunc(
 					resource.TestCheckResourceAttr("storagegrid_bucket.test", "name", bucketName),
 					resource.TestCheckResourceAttr("storagegrid_bucket.test", "region", defaultRegion),
+					resource.TestCheckResourceAttr("storagegrid_bucket.test", "last_access_time_enabled", "false"),
+					resource.TestCheckResourceAttr("storagegrid_bucket.test", "consistency_level", "read-after-new-write"),
 				),
 			},
 			// Import
@@ -49,6 +52,95 @@ func TestBucketResource_DefaultRegion(t *testing.T) {
 	})
 }
 
+func TestBucketResource_LastAccessTime(t *testing.T) {
+	bucketName := fmt.Sprintf("tf-provider-acc-test-bucket-%d", time.Now().Unix())
+
+	config := func(enabled bool) string {
+		return fmt.Sprintf(`
+resource "storagegrid_bucket" "test" {
+	name                     = "%s"
+	last_access_time_enabled = %t
+}`, bucketName, enabled)
+	}
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"storagegrid": providerserver.NewProtocol6WithError(New("test")()),
+		},
+		Steps: []resource.TestStep{
+			// Create
+			{
+				Config: config(true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("storagegrid_bucket.test", "name", bucketName),
+					resource.TestCheckResourceAttr("storagegrid_bucket.test", "last_access_time_enabled", "true"),
+				),
+			},
+			// Update
+			{
+				Config: config(false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("storagegrid_bucket.test", "last_access_time_enabled", "false"),
+				),
+			},
+			// Import
+			{
+				ResourceName:                         "storagegrid_bucket.test",
+				ImportState:                          true,
+				ImportStateVerifyIdentifierAttribute: "name",
+				ImportStateId:                        bucketName,
+			},
+			// Delete testing is done automatically
+		},
+	})
+}
+
+func TestBucketResource_ConsistencyLevel(t *testing.T) {
+	bucketName := fmt.Sprintf("tf-provider-acc-test-bucket-%d", time.Now().Unix())
+
+	config := func(consistencyLevel string) string {
+		return fmt.Sprintf(`
+resource "storagegrid_bucket" "test" {
+	name              = "%s"
+	consistency_level = "%s"
+}`, bucketName, consistencyLevel)
+	}
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"storagegrid": providerserver.NewProtocol6WithError(New("test")()),
+		},
+		Steps: []resource.TestStep{
+			// Create
+			{
+				Config: config("strong-site"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("storagegrid_bucket.test", "name", bucketName),
+					resource.TestCheckResourceAttr("storagegrid_bucket.test", "consistency_level", "strong-site"),
+				),
+			},
+			// Update in place
+			{
+				Config: config("available"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("storagegrid_bucket.test", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("storagegrid_bucket.test", "consistency_level", "available"),
+				),
+			},
+			// Invalid consistency level
+			{
+				Config:      config("eventual"),
+				ExpectError: regexp.MustCompile("Invalid Attribute Value Match"),
+			},
+			// Delete testing is done automatically
+		},
+	})
+}
+
 func TestBucketResource_CustomRegion(t *testing.T) {
 	bucketName := fmt.Sprintf("tf-provider-acc-test-bucket-%d", time.Now().Unix())
 	region := "eu-west-1"
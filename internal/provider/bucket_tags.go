@@ -0,0 +1,133 @@
+// Copyright (c) github.com/dmpe
+// SPDX-License-Identifier: MIT
+
+package provider
+
+import (
+	"crypto/md5"
+	"encoding/base64"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"net/http"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type BucketTagsResourceModel struct {
+	BucketName      types.String            `tfsdk:"bucket_name"`
+	S3Endpoint      types.String            `tfsdk:"s3_endpoint"`
+	Region          types.String            `tfsdk:"region"`
+	AccessKey       types.String            `tfsdk:"access_key"`
+	SecretAccessKey types.String            `tfsdk:"secret_access_key"`
+	Tags            map[string]types.String `tfsdk:"tags"`
+}
+
+type TaggingXmlModel struct {
+	XMLName xml.Name      `xml:"Tagging"`
+	Xmlns   string        `xml:"xmlns,attr,omitempty"`
+	TagSet  []TagXmlModel `xml:"TagSet>Tag"`
+}
+
+type TagXmlModel struct {
+	Key   string `xml:"Key"`
+	Value string `xml:"Value"`
+}
+
+// s3Client creates the client for the S3 endpoint from the connection settings of the model.
+func (m *BucketTagsResourceModel) s3Client(insecure bool) *S3DataClient {
+	return NewS3DataClient(
+		m.S3Endpoint.ValueString(),
+		m.Region.ValueString(),
+		m.AccessKey.ValueString(),
+		m.SecretAccessKey.ValueString(),
+		insecure,
+	)
+}
+
+func (m *BucketTagsResourceModel) toTaggingXml() ([]byte, error) {
+	// sort the keys to send a stable document
+	keys := make([]string, 0, len(m.Tags))
+	for k := range m.Tags {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	tagging := TaggingXmlModel{Xmlns: s3_xmlns, TagSet: []TagXmlModel{}}
+	for _, k := range keys {
+		tagging.TagSet = append(tagging.TagSet, TagXmlModel{Key: k, Value: m.Tags[k].ValueString()})
+	}
+
+	out, err := xml.Marshal(tagging)
+	if err != nil {
+		return nil, fmt.Errorf("unable to render bucket tags: %w", err)
+	}
+
+	return out, nil
+}
+
+func (m *BucketTagsResourceModel) upsert(client *S3DataClient) (*BucketTagsResourceModel, error) {
+	payload, err := m.toTaggingXml()
+	if err != nil {
+		return nil, err
+	}
+
+	// PutBucketTagging requires the MD5 digest of the body
+	digest := md5.Sum(payload)
+	headers := map[string]string{
+		"Content-MD5":  base64.StdEncoding.EncodeToString(digest[:]),
+		"Content-Type": "application/xml",
+	}
+
+	_, respCode, err := client.SendRequest("PUT", m.BucketName.ValueString(), "tagging", headers, payload, 204)
+	if err != nil {
+		if respCode == http.StatusNotFound {
+			return nil, ErrBucketNotFound
+		}
+		return nil, fmt.Errorf("unable to create or update bucket tags: %w", err)
+	}
+
+	return m.read(client)
+}
+
+func (m *BucketTagsResourceModel) read(client *S3DataClient) (*BucketTagsResourceModel, error) {
+	model := *m
+	model.Tags = map[string]types.String{}
+
+	respBody, respCode, err := client.SendRequest("GET", m.BucketName.ValueString(), "tagging", nil, nil, 200)
+	if err != nil {
+		var s3Err *S3Error
+		// a bucket without tags is answered with NoSuchTagSet
+		if errors.As(err, &s3Err) && s3Err.Code == "NoSuchTagSet" {
+			return &model, nil
+		}
+		if respCode == http.StatusNotFound {
+			return nil, ErrBucketNotFound
+		}
+		return nil, fmt.Errorf("unable to read bucket tags: %w", err)
+	}
+
+	var tagging TaggingXmlModel
+	if err := xml.Unmarshal(respBody, &tagging); err != nil {
+		return nil, &GenericError{Summary: "Client Error", Details: "Unable to parse bucket tags XML, got error: " + err.Error()}
+	}
+
+	for _, tag := range tagging.TagSet {
+		model.Tags[tag.Key] = types.StringValue(tag.Value)
+	}
+
+	return &model, nil
+}
+
+func (m *BucketTagsResourceModel) delete(client *S3DataClient) error {
+	_, respCode, err := client.SendRequest("DELETE", m.BucketName.ValueString(), "tagging", nil, nil, 204)
+	if err != nil {
+		if respCode == http.StatusNotFound {
+			return ErrBucketNotFound
+		}
+		return fmt.Errorf("unable to delete bucket tags: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,186 @@
+// Copyright (c) github.com/dmpe
+// SPDX-License-Identifier: MIT
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider-defined types fully satisfy framework interfaces.
+var (
+	_ resource.Resource              = &bucketTagsResource{}
+	_ resource.ResourceWithConfigure = &bucketTagsResource{}
+)
+
+// NewBucketTagsResource returns a new resource instance.
+func NewBucketTagsResource() resource.Resource {
+	return &bucketTagsResource{}
+}
+
+type bucketTagsResource struct {
+	client *S3GridClient
+}
+
+func (r *bucketTagsResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_bucket_tags"
+}
+
+func (r *bucketTagsResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `
+Manage the tags of the named bucket. Removing this resource deletes all tags of the referenced bucket.
+
+**Note:**
+- Bucket tags are not part of the management API. They are managed through the S3 endpoint of StorageGrid (PutBucketTagging), hence S3 credentials of the tenant are required, e.g. from 'storagegrid_s3_access_key'.
+- The 'insecure' setting of the provider applies to the S3 endpoint as well.
+- Import is not supported, as the S3 credentials cannot be passed on import.
+`,
+		Attributes: map[string]schema.Attribute{
+			"bucket_name": schema.StringAttribute{
+				Required:    true,
+				Description: "The name of the bucket",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"s3_endpoint": schema.StringAttribute{
+				Required:    true,
+				Description: "The URL of the S3 endpoint of StorageGrid, e.g. 'https://s3.example.com:10443'. Buckets are addressed in path-style.",
+			},
+			"region": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "The region used to sign the requests. Defaults to 'us-east-1'.",
+				Default:     stringdefault.StaticString(s3_default_region),
+			},
+			"access_key": schema.StringAttribute{
+				Required:    true,
+				Description: "The S3 access key used to sign the requests.",
+			},
+			"secret_access_key": schema.StringAttribute{
+				Required:    true,
+				Sensitive:   true,
+				Description: "The S3 secret access key used to sign the requests.",
+			},
+			"tags": schema.MapAttribute{
+				ElementType: types.StringType,
+				Required:    true,
+				Description: "The tags of the bucket. At most 50 tags are allowed.",
+				Validators: []validator.Map{
+					mapvalidator.SizeBetween(1, 50),
+					mapvalidator.KeysAre(stringvalidator.LengthBetween(1, 128)),
+					mapvalidator.ValueStringsAre(stringvalidator.LengthAtMost(256)),
+				},
+			},
+		},
+	}
+}
+
+func (r *bucketTagsResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*S3GridClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *bucketTagsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan BucketTagsResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tags, err := plan.upsert(plan.s3Client(r.client.insecure))
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, tags)...)
+}
+
+func (r *bucketTagsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan BucketTagsResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tags, err := plan.upsert(plan.s3Client(r.client.insecure))
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, tags)...)
+}
+
+func (r *bucketTagsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state BucketTagsResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	read, err := state.read(state.s3Client(r.client.insecure))
+	if err != nil {
+		if errors.Is(err, ErrBucketNotFound) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Error Reading StorageGrid container tags", err.Error())
+		return
+	}
+
+	// tags have been removed outside of Terraform
+	if len(read.Tags) == 0 {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, read)...)
+}
+
+func (r *bucketTagsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state BucketTagsResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := state.delete(state.s3Client(r.client.insecure))
+	if err != nil && !errors.Is(err, ErrBucketNotFound) {
+		resp.Diagnostics.AddError("Error Deleting StorageGrid container tags", err.Error())
+		return
+	}
+}
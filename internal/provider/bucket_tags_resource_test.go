@@ -0,0 +1,78 @@
+// Copyright (c) github.com/dmpe
+// SPDX-License-Identifier: MIT
+
+package provider
+
+import (
+	"fmt"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestBucketTagsResource(t *testing.T) {
+	s3Endpoint := os.Getenv("STORAGEGRID_S3_ENDPOINT")
+	if s3Endpoint == "" {
+		t.Skip("STORAGEGRID_S3_ENDPOINT must be set to test bucket tags")
+	}
+
+	bucketName := fmt.Sprintf("tf-provider-acc-test-bucket-tags-%d", time.Now().Unix())
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"storagegrid": providerserver.NewProtocol6WithError(New("test")()),
+		},
+		Steps: []resource.TestStep{
+			// Create
+			{
+				Config: bucketTagsConfiguration(bucketName, s3Endpoint, `team = "storage"`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("storagegrid_bucket_tags.test", "bucket_name", bucketName),
+					resource.TestCheckResourceAttr("storagegrid_bucket_tags.test", "region", "us-east-1"),
+					resource.TestCheckResourceAttr("storagegrid_bucket_tags.test", "tags.%", "1"),
+					resource.TestCheckResourceAttr("storagegrid_bucket_tags.test", "tags.team", "storage"),
+				),
+			},
+			// Update
+			{
+				Config: bucketTagsConfiguration(bucketName, s3Endpoint, `team = "storage"
+    cost-center = "1234"`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("storagegrid_bucket_tags.test", "tags.%", "2"),
+					resource.TestCheckResourceAttr("storagegrid_bucket_tags.test", "tags.cost-center", "1234"),
+				),
+			},
+		},
+	})
+}
+
+func bucketTagsConfiguration(bucketName string, s3Endpoint string, tags string) string {
+	return fmt.Sprintf(`
+resource "storagegrid_bucket" "test" {
+  name = "%s"
+}
+
+data "storagegrid_user" "test" {
+  unique_name = "user/%s"
+}
+
+resource "storagegrid_s3_access_key" "test" {
+  user_uuid = data.storagegrid_user.test.id
+}
+
+resource "storagegrid_bucket_tags" "test" {
+  bucket_name       = storagegrid_bucket.test.name
+  s3_endpoint       = "%s"
+  access_key        = storagegrid_s3_access_key.test.access_key
+  secret_access_key = storagegrid_s3_access_key.test.secret_access_key
+
+  tags = {
+    %s
+  }
+}
+`, bucketName, os.Getenv("STORAGEGRID_USERNAME"), s3Endpoint, tags)
+}
@@ -0,0 +1,136 @@
+// Copyright (c) github.com/dmpe
+// SPDX-License-Identifier: MIT
+
+package provider
+
+import (
+	"crypto/md5"
+	"encoding/base64"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+)
+
+// newS3TaggingStandIn starts a minimal S3 server, which keeps the tagging document of a single bucket in memory.
+func newS3TaggingStandIn(bucketName string) *httptest.Server {
+	var tagging []byte
+
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if !strings.HasPrefix(r.Header.Get("Authorization"), "AWS4-HMAC-SHA256 Credential=access/") {
+			w.WriteHeader(http.StatusForbidden)
+			return
+		}
+		if _, ok := r.URL.Query()["tagging"]; !ok {
+			w.WriteHeader(http.StatusNotImplemented)
+			return
+		}
+		if r.URL.Path != "/"+bucketName {
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`<Error><Code>NoSuchBucket</Code><Message>The specified bucket does not exist</Message></Error>`))
+			return
+		}
+
+		switch r.Method {
+		case "PUT":
+			body, _ := io.ReadAll(r.Body)
+			digest := md5.Sum(body)
+			if r.Header.Get("Content-MD5") != base64.StdEncoding.EncodeToString(digest[:]) {
+				w.WriteHeader(http.StatusBadRequest)
+				_, _ = w.Write([]byte(`<Error><Code>BadDigest</Code><Message>The Content-MD5 you specified did not match what we received.</Message></Error>`))
+				return
+			}
+			tagging = body
+			w.WriteHeader(http.StatusNoContent)
+		case "GET":
+			if tagging == nil {
+				w.WriteHeader(http.StatusNotFound)
+				_, _ = w.Write([]byte(`<Error><Code>NoSuchTagSet</Code><Message>The TagSet does not exist</Message></Error>`))
+				return
+			}
+			_, _ = w.Write(tagging)
+		case "DELETE":
+			tagging = nil
+			w.WriteHeader(http.StatusNoContent)
+		}
+	}))
+}
+
+func TestBucketTagsResourceModel_XML(t *testing.T) {
+	model := BucketTagsResourceModel{
+		BucketName: types.StringValue("test-bucket"),
+		Tags: map[string]types.String{
+			"team":        types.StringValue("storage"),
+			"cost-center": types.StringValue("1234"),
+		},
+	}
+
+	payload, err := model.toTaggingXml()
+	assert.NoError(t, err)
+	assert.Equal(t, `<Tagging xmlns="http://s3.amazonaws.com/doc/2006-03-01/"><TagSet>`+
+		`<Tag><Key>cost-center</Key><Value>1234</Value></Tag>`+
+		`<Tag><Key>team</Key><Value>storage</Value></Tag>`+
+		`</TagSet></Tagging>`, string(payload))
+}
+
+func TestBucketTagsResourceModel_Lifecycle(t *testing.T) {
+	server := newS3TaggingStandIn("test-bucket")
+	defer server.Close()
+
+	model := BucketTagsResourceModel{
+		BucketName:      types.StringValue("test-bucket"),
+		S3Endpoint:      types.StringValue(server.URL),
+		Region:          types.StringValue(s3_default_region),
+		AccessKey:       types.StringValue("access"),
+		SecretAccessKey: types.StringValue("secret"),
+		Tags: map[string]types.String{
+			"team": types.StringValue("storage"),
+		},
+	}
+	client := model.s3Client(false)
+
+	// no tags yet
+	read, err := model.read(client)
+	assert.NoError(t, err)
+	assert.Empty(t, read.Tags)
+
+	created, err := model.upsert(client)
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]types.String{"team": types.StringValue("storage")}, created.Tags)
+	assert.Equal(t, "access", created.AccessKey.ValueString())
+
+	model.Tags["cost-center"] = types.StringValue("1234")
+	updated, err := model.upsert(client)
+	assert.NoError(t, err)
+	assert.Len(t, updated.Tags, 2)
+
+	assert.NoError(t, model.delete(client))
+	read, err = model.read(client)
+	assert.NoError(t, err)
+	assert.Empty(t, read.Tags)
+}
+
+func TestBucketTagsResourceModel_BucketNotFound(t *testing.T) {
+	server := newS3TaggingStandIn("test-bucket")
+	defer server.Close()
+
+	model := BucketTagsResourceModel{
+		BucketName:      types.StringValue("missing-bucket"),
+		S3Endpoint:      types.StringValue(server.URL),
+		Region:          types.StringValue(s3_default_region),
+		AccessKey:       types.StringValue("access"),
+		SecretAccessKey: types.StringValue("secret"),
+		Tags:            map[string]types.String{"team": types.StringValue("storage")},
+	}
+	client := model.s3Client(false)
+
+	_, err := model.read(client)
+	assert.ErrorIs(t, err, ErrBucketNotFound)
+
+	_, err = model.upsert(client)
+	assert.ErrorIs(t, err, ErrBucketNotFound)
+}
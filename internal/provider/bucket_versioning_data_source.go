@@ -62,6 +62,8 @@ func (d *bucketVersioningDataSource) Configure(_ context.Context, req datasource
 		return
 	}
 
+	resp.Diagnostics.Append(requireTenantScope(client)...)
+
 	d.client = client
 }
 
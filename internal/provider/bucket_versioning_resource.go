@@ -81,6 +81,8 @@ func (r *bucketVersioningResource) Configure(_ context.Context, req resource.Con
 		return
 	}
 
+	resp.Diagnostics.Append(requireTenantScope(client)...)
+
 	r.client = client
 }
 
@@ -0,0 +1,123 @@
+// Copyright (c) github.com/dmpe
+// SPDX-License-Identifier: MIT
+
+package provider
+
+import (
+	"crypto/sha256"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/pem"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// certificateExpiryWarningDays is the default number of days before the expiry of a certificate, from which on every
+// plan shows a warning.
+const certificateExpiryWarningDays = 30
+
+// parseCertificate returns the first certificate of the given PEM encoded certificates.
+func parseCertificate(encoded string) (*x509.Certificate, error) {
+	block, _ := pem.Decode([]byte(encoded))
+	if block == nil || block.Type != "CERTIFICATE" {
+		return nil, fmt.Errorf("no PEM encoded certificate found")
+	}
+	return x509.ParseCertificate(block.Bytes)
+}
+
+// certificateFingerprint returns the SHA-256 fingerprint of the certificate, as shown by StorageGRID, e.g. "3A:F1:...".
+func certificateFingerprint(certificate *x509.Certificate) string {
+	sum := sha256.Sum256(certificate.Raw)
+	hex := make([]string, len(sum))
+	for i, b := range sum {
+		hex[i] = fmt.Sprintf("%02X", b)
+	}
+	return strings.Join(hex, ":")
+}
+
+// certificateAttributes returns the fingerprint and the expiry of the given PEM encoded certificate. Both are null if
+// the certificate cannot be parsed.
+func certificateAttributes(encoded string) (types.String, types.String) {
+	certificate, err := parseCertificate(encoded)
+	if err != nil {
+		return types.StringNull(), types.StringNull()
+	}
+	return types.StringValue(certificateFingerprint(certificate)), types.StringValue(certificate.NotAfter.UTC().Format(time.RFC3339))
+}
+
+// reconcileCertificate returns the PEM encoded certificate for the state. StorageGRID may change the whitespace of
+// the certificate, therefore the prior certificate is kept as long as StorageGRID returns the same certificate.
+func reconcileCertificate(prior types.String, encoded string) types.String {
+	if !prior.IsNull() && !prior.IsUnknown() && strings.TrimSpace(prior.ValueString()) == strings.TrimSpace(encoded) {
+		return prior
+	}
+	return stringOrNull(encoded)
+}
+
+// validateCertificate rejects a certificate which is not PEM encoded, and a private key which does not belong to the
+// certificate. Unknown and null values are skipped.
+func validateCertificate(certificatePath path.Path, certificate types.String, privateKeyPath path.Path, privateKey types.String) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if certificate.IsNull() || certificate.IsUnknown() {
+		return diags
+	}
+
+	if _, err := parseCertificate(certificate.ValueString()); err != nil {
+		diags.AddAttributeError(certificatePath, "Invalid certificate", "Unable to parse the PEM encoded certificate, got error: "+err.Error())
+		return diags
+	}
+
+	if privateKey.IsNull() || privateKey.IsUnknown() {
+		return diags
+	}
+
+	if _, err := tls.X509KeyPair([]byte(certificate.ValueString()), []byte(privateKey.ValueString())); err != nil {
+		diags.AddAttributeError(privateKeyPath, "Invalid private key", "The private key does not belong to the certificate, got error: "+err.Error())
+	}
+
+	return diags
+}
+
+// planCertificate sets the planned fingerprint and expiry of the certificate, and warns if the certificate expires
+// within the given number of days. As it runs on every plan, the warning shows up until the certificate is rotated.
+func planCertificate(certificatePath path.Path, certificate types.String, warningDays types.Int64, fingerprint *types.String, expiresAt *types.String) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if certificate.IsUnknown() {
+		*fingerprint = types.StringUnknown()
+		*expiresAt = types.StringUnknown()
+		return diags
+	}
+
+	parsed, err := parseCertificate(certificate.ValueString())
+	if err != nil {
+		// rejected by the validation of the configuration
+		return diags
+	}
+
+	*fingerprint = types.StringValue(certificateFingerprint(parsed))
+	*expiresAt = types.StringValue(parsed.NotAfter.UTC().Format(time.RFC3339))
+
+	if warningDays.IsUnknown() {
+		return diags
+	}
+
+	remaining := time.Until(parsed.NotAfter)
+	switch {
+	case remaining <= 0:
+		diags.AddAttributeWarning(certificatePath, "Certificate expired",
+			fmt.Sprintf("The certificate '%s' expired on %s. Rotate the certificate.", parsed.Subject, expiresAt.ValueString()))
+	case remaining < time.Duration(warningDays.ValueInt64())*24*time.Hour:
+		diags.AddAttributeWarning(certificatePath, "Certificate expires soon",
+			fmt.Sprintf("The certificate '%s' expires on %s, in %d days. Rotate the certificate.",
+				parsed.Subject, expiresAt.ValueString(), int64(remaining.Hours()/24)))
+	}
+
+	return diags
+}
@@ -0,0 +1,135 @@
+// Copyright (c) github.com/dmpe
+// SPDX-License-Identifier: MIT
+
+package provider
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+)
+
+// newTestCertificate returns a PEM encoded self-signed certificate, which expires at the given time, and its private key.
+func newTestCertificate(t *testing.T, notAfter time.Time) (string, string) {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "tf-provider-acc-test"},
+		DNSNames:     []string{"tf-provider-acc-test"},
+		NotBefore:    notAfter.AddDate(-1, 0, 0),
+		NotAfter:     notAfter,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	keyDer, err := x509.MarshalPKCS8PrivateKey(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})),
+		string(pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: keyDer}))
+}
+
+func Test_certificateAttributes(t *testing.T) {
+	notAfter := time.Date(2030, 1, 2, 3, 4, 5, 0, time.UTC)
+	certificate, _ := newTestCertificate(t, notAfter)
+
+	fingerprint, expiresAt := certificateAttributes(certificate)
+	assert.Regexp(t, `^([0-9A-F]{2}:){31}[0-9A-F]{2}$`, fingerprint.ValueString())
+	assert.Equal(t, "2030-01-02T03:04:05Z", expiresAt.ValueString())
+
+	fingerprint, expiresAt = certificateAttributes("not a certificate")
+	assert.True(t, fingerprint.IsNull())
+	assert.True(t, expiresAt.IsNull())
+}
+
+func Test_reconcileCertificate(t *testing.T) {
+	certificate, _ := newTestCertificate(t, time.Now().AddDate(1, 0, 0))
+
+	prior := types.StringValue(certificate + "\n")
+	assert.Equal(t, prior, reconcileCertificate(prior, certificate))
+	assert.Equal(t, types.StringValue(certificate), reconcileCertificate(types.StringValue("other"), certificate))
+	assert.True(t, reconcileCertificate(types.StringNull(), "").IsNull())
+}
+
+func Test_validateCertificate(t *testing.T) {
+	certificate, privateKey := newTestCertificate(t, time.Now().AddDate(1, 0, 0))
+	_, otherPrivateKey := newTestCertificate(t, time.Now().AddDate(1, 0, 0))
+
+	tests := []struct {
+		name        string
+		certificate types.String
+		privateKey  types.String
+		errors      []string
+	}{
+		{"valid", types.StringValue(certificate), types.StringValue(privateKey), nil},
+		{"invalid certificate", types.StringValue("not a certificate"), types.StringValue(privateKey), []string{"Invalid certificate"}},
+		{"other private key", types.StringValue(certificate), types.StringValue(otherPrivateKey), []string{"Invalid private key"}},
+		{"unknown values", types.StringUnknown(), types.StringUnknown(), nil},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var summaries []string
+			for _, d := range validateCertificate(path.Root("certificate"), test.certificate, path.Root("private_key_wo"), test.privateKey) {
+				summaries = append(summaries, d.Summary())
+			}
+			assert.Equal(t, test.errors, summaries)
+		})
+	}
+}
+
+func Test_planCertificate(t *testing.T) {
+	valid, _ := newTestCertificate(t, time.Now().AddDate(1, 0, 0))
+	expiresSoon, _ := newTestCertificate(t, time.Now().AddDate(0, 0, 10))
+	expired, _ := newTestCertificate(t, time.Now().AddDate(0, 0, -1))
+
+	tests := []struct {
+		name        string
+		certificate types.String
+		warningDays int64
+		warnings    []string
+	}{
+		{"valid", types.StringValue(valid), 30, nil},
+		{"expires soon", types.StringValue(expiresSoon), 30, []string{"Certificate expires soon"}},
+		{"expires after warning", types.StringValue(expiresSoon), 7, nil},
+		{"expired", types.StringValue(expired), 30, []string{"Certificate expired"}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			fingerprint, expiresAt := types.StringUnknown(), types.StringUnknown()
+			var summaries []string
+			for _, d := range planCertificate(path.Root("certificate"), test.certificate, types.Int64Value(test.warningDays), &fingerprint, &expiresAt) {
+				summaries = append(summaries, d.Summary())
+			}
+			assert.Equal(t, test.warnings, summaries)
+			assert.False(t, fingerprint.IsUnknown())
+			assert.False(t, expiresAt.IsUnknown())
+		})
+	}
+
+	fingerprint, expiresAt := types.StringNull(), types.StringNull()
+	assert.Empty(t, planCertificate(path.Root("certificate"), types.StringUnknown(), types.Int64Value(30), &fingerprint, &expiresAt))
+	assert.True(t, fingerprint.IsUnknown())
+	assert.True(t, expiresAt.IsUnknown())
+}
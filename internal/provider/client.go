@@ -8,11 +8,18 @@ import (
 	"bytes"
 	"crypto/tls"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"net/http"
+	"os"
+	"strings"
+	"time"
 )
 
+// tokenExpiryMargin is the time before the expiry of a token, from which on the client authorizes again.
+const tokenExpiryMargin = time.Minute
+
 type HttpClient interface {
 	SendRequest(method string, path string, payload interface{}, statusCode int) (value []byte, respheaders string, respCode int, err error)
 }
@@ -29,6 +36,14 @@ func NewTokenClient(url string, bearerToken string, insecure bool) *S3GridClient
 	return gridClient
 }
 
+// NewGridTokenClient creates the HTTP client of a grid administrator for calling the Grid Management API
+func NewGridTokenClient(url string, bearerToken string, insecure bool) *S3GridClient {
+	gridClient := NewTokenClient(url, bearerToken, insecure)
+	gridClient.gridAdmin = true
+
+	return gridClient
+}
+
 // NewUsernamePasswordClient is used to create final Bearer Token
 func NewUsernamePasswordClient(url string, username string, password string, tenant string, insecure bool) *S3GridClient {
 	gridClient := &S3GridClient{
@@ -43,12 +58,57 @@ func NewUsernamePasswordClient(url string, username string, password string, ten
 	return gridClient
 }
 
+// NewGridUsernamePasswordClient is used to create the Bearer Token of a grid administrator, which is required by the
+// Grid Management API. No tenant account ID is sent to the authorize endpoint.
+func NewGridUsernamePasswordClient(url string, username string, password string, insecure bool) *S3GridClient {
+	gridClient := NewUsernamePasswordClient(url, username, password, "", insecure)
+	gridClient.gridAdmin = true
+
+	return gridClient
+}
+
+// NewSamlClient is used to create the Bearer Token with single sign-on (SAML). The SAML response of the identity
+// provider is returned by the given function.
+func NewSamlClient(url string, tenant string, samlResponse SamlResponseFunc, insecure bool) *S3GridClient {
+	gridClient := &S3GridClient{
+		address:      url,
+		tenant:       tenant,
+		samlResponse: samlResponse,
+		insecure:     insecure,
+		httpClient:   &http.Client{},
+	}
+
+	return gridClient
+}
+
+// NewGridSamlClient is used to create the Bearer Token of a grid administrator with single sign-on (SAML). The grid
+// administrators sign in with the account ID "0".
+func NewGridSamlClient(url string, samlResponse SamlResponseFunc, insecure bool) *S3GridClient {
+	gridClient := NewSamlClient(url, saml_grid_account_id, samlResponse, insecure)
+	gridClient.gridAdmin = true
+
+	return gridClient
+}
+
+// client returns the HTTP client for a request, which skips the verification of the certificates if insecure is set.
+func (c *S3GridClient) client() *http.Client {
+	if c.insecure {
+		tr := &http.Transport{
+			Proxy:           http.ProxyFromEnvironment,
+			TLSClientConfig: &tls.Config{InsecureSkipVerify: true},
+		}
+		return &http.Client{Transport: tr}
+	}
+
+	return &http.Client{}
+}
+
 // SendRequest send a http request to create Bearer Token
 func (c *S3GridClient) SendAuthorizeRequest(statusCode int) (tokenValue string, respCode int, err error) {
 	var jsonD S3GridClientReturnJson
 
 	address := c.address + api_suffix + api_auth
-	client := &http.Client{}
+	client := c.client()
 
 	postRequest := &S3GridClientJson{
 		AccountId: c.tenant,
@@ -64,57 +124,37 @@ func (c *S3GridClient) SendAuthorizeRequest(statusCode int) (tokenValue string,
 		return "", 0, err
 	}
 
-	if c.insecure {
-		tr := &http.Transport{
-			Proxy:           http.ProxyFromEnvironment,
-			TLSClientConfig: &tls.Config{InsecureSkipVerify: true},
-		}
-		client = &http.Client{Transport: tr}
-	}
-
-	req, err := http.NewRequest("POST", address, b)
+	// the authorize request is repeated like any other request, while StorageGRID is unavailable
+	resp, err := c.doRequest(client, "POST", address, b.Bytes(), "")
 	if err != nil {
-		return "", 0, err
-	}
-
-	req.Header.Add("accept", "application/json")
-	req.Header.Add("Content-Type", "application/json")
-
-	resp, err := client.Do(req)
-	if err != nil {
-		if resp != nil {
-			return "", resp.StatusCode, err
-		} else {
-			return "", http.StatusBadGateway, err
-		}
+		return "", http.StatusBadGateway, connectionError(c.address, err)
 	}
+	defer resp.Body.Close()
 
 	body, err := io.ReadAll(resp.Body)
-
-	if err := json.Unmarshal(body, &jsonD); err != nil {
-		return "", 0, err
-	}
-
 	if err != nil {
 		return "", resp.StatusCode, err
 	}
-	resp.Body.Close()
 
 	if statusCode != 0 && resp.StatusCode != statusCode {
-		return "", resp.StatusCode, fmt.Errorf("[ERROR] unexpected status code got: %v expected: %v \n %v", statusCode, resp.StatusCode, statusCode)
+		return "", resp.StatusCode, authenticationError("the username and password", resp.StatusCode, body)
+	}
+
+	if err := json.Unmarshal(body, &jsonD); err != nil {
+		return "", resp.StatusCode, err
 	}
 
 	// returns JSON body - see provider.go
 	return jsonD.Data, resp.StatusCode, nil
 }
 
-// SendRequest send a http request, with bearer token appended
+// SendRequest send a http request, with bearer token appended. If the token expires soon, or StorageGRID rejects the
+// token, the client authorizes again and the rejected request is repeated once with the renewed token.
 func (c *S3GridClient) SendRequest(method string, path string, payload interface{}, statusCode int) (value []byte, respheaders string, respCode int, err error) {
 	address := c.address + api_suffix + path
-	bearer := "Bearer " + c.token
-	client := &http.Client{}
+	client := c.client()
 
-	var bodyReader io.Reader = nil
+	var payloadBytes []byte
 
 	// Only encode payload if it's not nil
 	if payload != nil {
@@ -123,30 +163,24 @@ func (c *S3GridClient) SendRequest(method string, path string, payload interface
 		if err != nil {
 			return nil, "", 0, err
 		}
-		bodyReader = b
+		payloadBytes = b.Bytes()
 	}
 
-	if c.insecure {
-		tr := &http.Transport{
-			Proxy:           http.ProxyFromEnvironment,
-			TLSClientConfig: &tls.Config{InsecureSkipVerify: true},
-		}
-		client = &http.Client{Transport: tr}
-	}
+	token := c.bearerToken()
 
-	req, err := http.NewRequest(method, address, bodyReader)
-	if err != nil {
-		return nil, "", 0, err
-	}
-
-	// Use access token authentication if bearer token is specified
-	if c.token != "" {
-		req.Header.Add("Authorization", bearer)
+	// authorize before the first request if the provider skipped the authentication check, and before the token
+	// expires, instead of sending a request which is rejected anyway
+	if token == "" || c.tokenExpiresSoon() {
+		renewed, err := c.reauthorize(token)
+		if err != nil && token == "" {
+			return nil, "", http.StatusUnauthorized, err
+		}
+		if err == nil {
+			token = renewed
+		}
 	}
 
-	req.Header.Add("Content-Type", "application/json")
-
-	resp, err := client.Do(req)
+	resp, err := c.doRequest(client, method, address, payloadBytes, token)
 	if err != nil {
 		if resp != nil {
 			return nil, "", resp.StatusCode, err
@@ -155,6 +189,21 @@ func (c *S3GridClient) SendRequest(method string, path string, payload interface
 		}
 	}
 
+	if resp.StatusCode == http.StatusUnauthorized {
+		renewed, err := c.reauthorize(token)
+		if errors.Is(err, ErrSamlResponseNotRenewable) {
+			resp.Body.Close()
+			return nil, "", resp.StatusCode, err
+		}
+		if err == nil && renewed != token {
+			resp.Body.Close()
+			resp, err = c.doRequest(client, method, address, payloadBytes, renewed)
+			if err != nil {
+				return nil, "", http.StatusBadGateway, err
+			}
+		}
+	}
+
 	body, err := io.ReadAll(resp.Body)
 	if err != nil {
 		return nil, "", resp.StatusCode, err
@@ -175,3 +224,225 @@ func (c *S3GridClient) SendRequest(method string, path string, payload interface
 	// body is of type bytes
 	return body, string(headers), resp.StatusCode, nil
 }
+
+// doRequest sends a request with the given bearer token. After a transient failure, see retryable, the request is
+// repeated up to maxRetries times.
+func (c *S3GridClient) doRequest(client *http.Client, method string, address string, payload []byte, token string) (*http.Response, error) {
+	for retry := 0; ; retry++ {
+		var bodyReader io.Reader = nil
+		if payload != nil {
+			bodyReader = bytes.NewReader(payload)
+		}
+
+		req, err := http.NewRequest(method, address, bodyReader)
+		if err != nil {
+			return nil, err
+		}
+
+		// Use access token authentication if bearer token is specified
+		if token != "" {
+			req.Header.Add("Authorization", "Bearer "+token)
+		}
+
+		req.Header.Add("Content-Type", "application/json")
+
+		resp, err := client.Do(req)
+		if retry >= c.maxRetries || !retryable(method, resp, err) {
+			return resp, err
+		}
+
+		wait := c.retryWait(retry, resp)
+		if resp != nil {
+			resp.Body.Close()
+		}
+		time.Sleep(wait)
+	}
+}
+
+// bearerToken returns the current bearer token of the client.
+func (c *S3GridClient) bearerToken() string {
+	c.tokenMu.Lock()
+	defer c.tokenMu.Unlock()
+
+	return c.token
+}
+
+// authorize creates a new bearer token with the authentication method of the client: it reads the token file, or it
+// signs in with single sign-on (SAML) or with username and password. The expiry of the new token is read from the
+// configuration of the account. A pre-issued token cannot be renewed.
+func (c *S3GridClient) authorize() error {
+	var token string
+	var err error
+
+	switch {
+	case c.tokenFile != "":
+		token, err = readTokenFile(c.tokenFile)
+	case c.samlResponse != nil:
+		token, _, err = c.SendAuthorizeSamlRequest(200)
+	case c.username != "":
+		token, _, err = c.SendAuthorizeRequest(200)
+	default:
+		return ErrTokenNotRenewable
+	}
+	if err != nil {
+		return err
+	}
+
+	// a token file that was not renewed keeps the known expiry
+	if token == c.token {
+		return nil
+	}
+
+	// unlike a new token of StorageGRID, the token of a token file may already be rejected
+	expires, err := c.tokenExpiry(token)
+	if err != nil && c.tokenFile != "" {
+		return err
+	}
+	c.token = token
+	c.tokenExpires = expires
+
+	return nil
+}
+
+// authenticate authorizes the client, or verifies its pre-issued token, before the first request. The returned
+// *GenericError describes why the authentication failed.
+func (c *S3GridClient) authenticate() error {
+	if c.token == "" {
+		return c.authorize()
+	}
+
+	expires, err := c.tokenExpiry(c.token)
+	if err != nil {
+		return err
+	}
+	c.tokenExpires = expires
+
+	return nil
+}
+
+// reauthorize authorizes again after StorageGRID rejected the given token, or before it expires, and returns the
+// renewed token. If another request renewed the token in the meantime, the client does not authorize again.
+func (c *S3GridClient) reauthorize(rejected string) (string, error) {
+	c.tokenMu.Lock()
+	defer c.tokenMu.Unlock()
+
+	if c.token != rejected {
+		return c.token, nil
+	}
+
+	if err := c.authorize(); err != nil {
+		return "", err
+	}
+
+	return c.token, nil
+}
+
+// tokenExpiresSoon returns true if the token expires within tokenExpiryMargin. The expiry of a token is unknown if the
+// configuration of the account could not be read.
+func (c *S3GridClient) tokenExpiresSoon() bool {
+	c.tokenMu.Lock()
+	defer c.tokenMu.Unlock()
+
+	return !c.tokenExpires.IsZero() && time.Now().Add(tokenExpiryMargin).After(c.tokenExpires)
+}
+
+// tokenExpiry returns the expiry of the given token from the configuration of the account. The zero time is returned
+// if StorageGRID does not report the expiry.
+func (c *S3GridClient) tokenExpiry(token string) (time.Time, error) {
+	var jsonD S3GridClientConfigJson
+
+	endpoint := api_config
+	if c.gridAdmin {
+		endpoint = api_grid_config
+	}
+
+	resp, err := c.doRequest(c.client(), "GET", c.address+api_suffix+endpoint, nil, token)
+	if err != nil {
+		return time.Time{}, connectionError(c.address, err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return time.Time{}, authenticationError("the bearer token", resp.StatusCode, body)
+	}
+
+	if err := json.Unmarshal(body, &jsonD); err != nil {
+		return time.Time{}, err
+	}
+
+	return jsonD.Data.Token.Expires, nil
+}
+
+// connectionError describes why the provider cannot connect to the given address of StorageGRID.
+func connectionError(address string, err error) error {
+	var verificationErr *tls.CertificateVerificationError
+	var recordHeaderErr tls.RecordHeaderError
+	var alertErr tls.AlertError
+
+	if errors.As(err, &verificationErr) || errors.As(err, &recordHeaderErr) || errors.As(err, &alertErr) {
+		return &GenericError{
+			Summary: "StorageGrid TLS failure",
+			Details: fmt.Sprintf("The provider cannot establish a TLS connection to %s, got error: %s. "+
+				"Trust the certificate of StorageGRID, or set insecure to skip the certificate verification.", address, err),
+		}
+	}
+
+	return &GenericError{
+		Summary: "Unreachable StorageGrid host",
+		Details: fmt.Sprintf("The provider cannot connect to %s, got error: %s. "+
+			"Check the address value, and that StorageGRID is reachable from this host.", address, err),
+	}
+}
+
+// authenticationError describes why StorageGRID rejected the given credentials, from the error response with the given
+// status code. StorageGRID reports an unknown tenant as error of the accountId field.
+func authenticationError(credentials string, respCode int, body []byte) error {
+	var jsonD S3GridClientErrorJson
+
+	message := strings.TrimSpace(string(body))
+	if err := json.Unmarshal(body, &jsonD); err == nil && jsonD.Message.Text != "" {
+		message = jsonD.Message.Text
+	}
+
+	for _, fieldErr := range append(jsonD.Errors, jsonD.Message) {
+		if fieldErr.Context == "accountId" {
+			return &GenericError{
+				Summary: "Unknown StorageGrid tenant",
+				Details: fmt.Sprintf("StorageGRID does not know the tenant account of the provider: %s. "+
+					"Check the tenant value, or set grid_admin to authenticate as grid administrator.", fieldErr.Text),
+			}
+		}
+	}
+
+	if respCode == http.StatusUnauthorized {
+		return &GenericError{
+			Summary: "Invalid StorageGrid credentials",
+			Details: fmt.Sprintf("StorageGRID rejected %s of the provider: %s", credentials, message),
+		}
+	}
+
+	return &GenericError{
+		Summary: "Unable to authenticate with StorageGrid",
+		Details: fmt.Sprintf("StorageGRID answered the authentication with status code %d: %s", respCode, message),
+	}
+}
+
+// readTokenFile returns the bearer token of the given file, without surrounding whitespace.
+func readTokenFile(tokenFile string) (string, error) {
+	content, err := os.ReadFile(tokenFile)
+	if err != nil {
+		return "", err
+	}
+
+	token := strings.TrimSpace(string(content))
+	if token == "" {
+		return "", fmt.Errorf("the token file %s is empty", tokenFile)
+	}
+
+	return token, nil
+}
@@ -0,0 +1,317 @@
+// Copyright (c) github.com/dmpe
+// SPDX-License-Identifier: MIT
+
+package provider
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// authStandIn is a stand-in for the authorization of StorageGRID. It issues numbered tokens for the user root of the
+// grid and of the tenant 27983238148563830000, which expire after the given lifetime, and records every request as
+// "METHOD path token".
+type authStandIn struct {
+	mu       sync.Mutex
+	lifetime time.Duration
+	tokens   map[string]time.Time
+	issued   int
+	requests []string
+	payloads []string
+}
+
+func newAuthStandIn(t *testing.T, lifetime time.Duration) (*authStandIn, *httptest.Server) {
+	standIn := &authStandIn{lifetime: lifetime, tokens: map[string]time.Time{}}
+	server := httptest.NewServer(standIn)
+	t.Cleanup(server.Close)
+	return standIn, server
+}
+
+func (s *authStandIn) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	body, _ := io.ReadAll(r.Body)
+	token := strings.TrimPrefix(r.Header.Get("Authorization"), "Bearer ")
+	s.requests = append(s.requests, strings.TrimSpace(fmt.Sprintf("%s %s %s", r.Method, r.URL.Path, token)))
+	s.payloads = append(s.payloads, string(body))
+
+	if r.URL.Path == "/api/v4/authorize" {
+		var credentials S3GridClientJson
+		if json.Unmarshal(body, &credentials) == nil && credentials.AccountId != "" && credentials.AccountId != "27983238148563830000" {
+			w.WriteHeader(http.StatusUnauthorized)
+			_, _ = w.Write([]byte(`{"code": 401, "message": {"text": "Unauthorized"}, "errors": [{"context": "accountId", "text": "Account not found"}]}`))
+			return
+		}
+		if credentials.Username != "root" || credentials.Password != "secret" {
+			w.WriteHeader(http.StatusUnauthorized)
+			_, _ = w.Write([]byte(`{"code": 401, "message": {"text": "Unauthorized"}}`))
+			return
+		}
+		s.issued++
+		token := fmt.Sprintf("token-%d", s.issued)
+		s.tokens[token] = time.Now().Add(s.lifetime)
+		_, _ = fmt.Fprintf(w, `{"data": %q}`, token)
+		return
+	}
+
+	expires, ok := s.tokens[token]
+	if !ok || time.Now().After(expires) {
+		w.WriteHeader(http.StatusUnauthorized)
+		_, _ = w.Write([]byte(`{"code": 401, "message": {"text": "Unauthorized"}}`))
+		return
+	}
+
+	switch r.URL.Path {
+	case "/api/v4/org/config", "/api/v4/grid/config":
+		_, _ = fmt.Fprintf(w, `{"data": {"token": {"expires": %q}}}`, expires.Format(time.RFC3339Nano))
+	default:
+		_, _ = w.Write([]byte(`{"data": {}}`))
+	}
+}
+
+// addToken accepts the given pre-issued token, e.g. of a token file.
+func (s *authStandIn) addToken(token string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.tokens[token] = time.Now().Add(s.lifetime)
+}
+
+// expireTokens expires all tokens, as if the apply outlived them.
+func (s *authStandIn) expireTokens() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for token := range s.tokens {
+		s.tokens[token] = time.Now().Add(-time.Second)
+	}
+	s.requests = nil
+	s.payloads = nil
+}
+
+func TestS3GridClient_SendRequest_Reauthorize(t *testing.T) {
+	standIn, server := newAuthStandIn(t, time.Hour)
+
+	client := NewUsernamePasswordClient(server.URL, "root", "secret", "27983238148563830000", false)
+	assert.NoError(t, client.authorize())
+	assert.Equal(t, []string{"POST /api/v4/authorize", "GET /api/v4/org/config token-1"}, standIn.requests)
+	assert.WithinDuration(t, time.Now().Add(time.Hour), client.tokenExpires, time.Minute)
+
+	standIn.expireTokens()
+
+	// the rejected request is repeated once with the renewed token
+	_, _, respCode, err := client.SendRequest("PUT", "/org/containers/test/versioning", map[string]bool{"versioningEnabled": true}, 200)
+	assert.NoError(t, err)
+	assert.Equal(t, 200, respCode)
+	assert.Equal(t, []string{
+		"PUT /api/v4/org/containers/test/versioning token-1",
+		"POST /api/v4/authorize",
+		"GET /api/v4/org/config token-2",
+		"PUT /api/v4/org/containers/test/versioning token-2",
+	}, standIn.requests)
+	assert.Equal(t, standIn.payloads[0], standIn.payloads[3])
+}
+
+func TestS3GridClient_SendRequest_ReauthorizeBeforeExpiry(t *testing.T) {
+	// the tokens expire within the margin, the client authorizes before every request
+	standIn, server := newAuthStandIn(t, tokenExpiryMargin/2)
+
+	client := NewGridUsernamePasswordClient(server.URL, "root", "secret", false)
+	assert.NoError(t, client.authorize())
+
+	_, _, respCode, err := client.SendRequest("GET", "/grid/accounts", nil, 200)
+	assert.NoError(t, err)
+	assert.Equal(t, 200, respCode)
+	assert.Equal(t, []string{
+		"POST /api/v4/authorize",
+		"GET /api/v4/grid/config token-1",
+		"POST /api/v4/authorize",
+		"GET /api/v4/grid/config token-2",
+		"GET /api/v4/grid/accounts token-2",
+	}, standIn.requests)
+}
+
+func TestS3GridClient_SendRequest_ReauthorizeConcurrently(t *testing.T) {
+	standIn, server := newAuthStandIn(t, time.Hour)
+
+	client := NewUsernamePasswordClient(server.URL, "root", "secret", "27983238148563830000", false)
+	assert.NoError(t, client.authorize())
+
+	standIn.expireTokens()
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, _, _, err := client.SendRequest("GET", "/org/containers", nil, 200)
+			assert.NoError(t, err)
+		}()
+	}
+	wg.Wait()
+
+	// only the first rejected request authorizes again
+	assert.Equal(t, 2, standIn.issued)
+}
+
+func TestS3GridClient_SendRequest_ReauthorizeFails(t *testing.T) {
+	standIn, server := newAuthStandIn(t, time.Hour)
+
+	client := NewUsernamePasswordClient(server.URL, "root", "secret", "27983238148563830000", false)
+	assert.NoError(t, client.authorize())
+
+	standIn.expireTokens()
+	client.password = "changed"
+
+	// the original error is returned, the request is not repeated
+	_, _, respCode, err := client.SendRequest("GET", "/org/containers", nil, 200)
+	assert.Error(t, err)
+	assert.Equal(t, 401, respCode)
+	assert.Equal(t, []string{"GET /api/v4/org/containers token-1", "POST /api/v4/authorize"}, standIn.requests)
+}
+
+func TestS3GridClient_SendRequest_PreIssuedToken(t *testing.T) {
+	standIn, server := newAuthStandIn(t, time.Hour)
+	standIn.addToken("pre-issued")
+
+	client := NewTokenClient(server.URL, "pre-issued", false)
+	assert.ErrorIs(t, client.authorize(), ErrTokenNotRenewable)
+
+	standIn.expireTokens()
+
+	// a pre-issued token cannot be renewed
+	_, _, respCode, err := client.SendRequest("GET", "/org/containers", nil, 200)
+	assert.Error(t, err)
+	assert.Equal(t, 401, respCode)
+	assert.Equal(t, []string{"GET /api/v4/org/containers pre-issued"}, standIn.requests)
+}
+
+func TestS3GridClient_SendRequest_TokenFile(t *testing.T) {
+	standIn, server := newAuthStandIn(t, time.Hour)
+	standIn.addToken("expired")
+
+	tokenFile := filepath.Join(t.TempDir(), "token")
+	assert.NoError(t, os.WriteFile(tokenFile, []byte("expired"), 0o600))
+
+	client := NewTokenClient(server.URL, "", false)
+	client.tokenFile = tokenFile
+	assert.NoError(t, client.authorize())
+
+	standIn.expireTokens()
+
+	// the request is not repeated as long as the token file is not renewed
+	_, _, respCode, err := client.SendRequest("GET", "/org/config", nil, 200)
+	assert.Error(t, err)
+	assert.Equal(t, 401, respCode)
+	assert.Equal(t, []string{"GET /api/v4/org/config expired"}, standIn.requests)
+
+	standIn.addToken("renewed")
+	assert.NoError(t, os.WriteFile(tokenFile, []byte("renewed\n"), 0o600))
+
+	// the rejected request is repeated with the renewed token of the file
+	_, _, respCode, err = client.SendRequest("GET", "/org/containers", nil, 200)
+	assert.NoError(t, err)
+	assert.Equal(t, 200, respCode)
+	assert.Equal(t, "GET /api/v4/org/containers renewed", standIn.requests[len(standIn.requests)-1])
+	assert.Equal(t, "renewed", client.bearerToken())
+}
+
+func TestS3GridClient_authenticate(t *testing.T) {
+	standIn, server := newAuthStandIn(t, time.Hour)
+	standIn.addToken("pre-issued")
+
+	// the TLS server is not trusted by the client, its handshake errors are expected
+	tlsServer := httptest.NewUnstartedServer(standIn)
+	tlsServer.Config.ErrorLog = log.New(io.Discard, "", 0)
+	tlsServer.StartTLS()
+	t.Cleanup(tlsServer.Close)
+
+	closedServer := httptest.NewServer(standIn)
+	closedServer.Close()
+
+	tokenFile := filepath.Join(t.TempDir(), "token")
+	assert.NoError(t, os.WriteFile(tokenFile, []byte("unknown"), 0o600))
+	tokenFileClient := NewTokenClient(server.URL, "", false)
+	tokenFileClient.tokenFile = tokenFile
+
+	tests := []struct {
+		name    string
+		client  *S3GridClient
+		summary string
+	}{
+		{"tenant user", NewUsernamePasswordClient(server.URL, "root", "secret", "27983238148563830000", false), ""},
+		{"grid administrator", NewGridUsernamePasswordClient(server.URL, "root", "secret", false), ""},
+		{"pre-issued token", NewTokenClient(server.URL, "pre-issued", false), ""},
+		{"bad credentials", NewUsernamePasswordClient(server.URL, "root", "wrong", "27983238148563830000", false), "Invalid StorageGrid credentials"},
+		{"unknown tenant", NewUsernamePasswordClient(server.URL, "root", "secret", "12345678901234567890", false), "Unknown StorageGrid tenant"},
+		{"bad token", NewTokenClient(server.URL, "unknown", false), "Invalid StorageGrid credentials"},
+		{"bad token file", tokenFileClient, "Invalid StorageGrid credentials"},
+		{"TLS failure", NewGridUsernamePasswordClient(tlsServer.URL, "root", "secret", false), "StorageGrid TLS failure"},
+		{"insecure TLS", NewGridUsernamePasswordClient(tlsServer.URL, "root", "secret", true), ""},
+		{"unreachable host", NewGridUsernamePasswordClient(closedServer.URL, "root", "secret", false), "Unreachable StorageGrid host"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.client.authenticate()
+			if tt.summary == "" {
+				assert.NoError(t, err)
+				assert.False(t, tt.client.tokenExpires.IsZero())
+				return
+			}
+			assert.ErrorIs(t, err, &GenericError{Summary: tt.summary})
+			assert.True(t, tt.client.tokenExpires.IsZero())
+		})
+	}
+}
+
+func TestS3GridClient_SendRequest_SkippedAuthentication(t *testing.T) {
+	standIn, server := newAuthStandIn(t, time.Hour)
+
+	// the client authorizes before the first request
+	client := NewUsernamePasswordClient(server.URL, "root", "secret", "27983238148563830000", false)
+	_, _, respCode, err := client.SendRequest("GET", "/org/containers", nil, 200)
+	assert.NoError(t, err)
+	assert.Equal(t, 200, respCode)
+	assert.Equal(t, []string{
+		"POST /api/v4/authorize",
+		"GET /api/v4/org/config token-1",
+		"GET /api/v4/org/containers token-1",
+	}, standIn.requests)
+
+	standIn.expireTokens()
+
+	// the authentication failure is returned, the request is not sent
+	client = NewUsernamePasswordClient(server.URL, "root", "wrong", "27983238148563830000", false)
+	_, _, respCode, err = client.SendRequest("GET", "/org/containers", nil, 200)
+	assert.ErrorIs(t, err, &GenericError{Summary: "Invalid StorageGrid credentials"})
+	assert.Equal(t, 401, respCode)
+	assert.Equal(t, []string{"POST /api/v4/authorize"}, standIn.requests)
+}
+
+func Test_readTokenFile(t *testing.T) {
+	tokenFile := filepath.Join(t.TempDir(), "token")
+
+	_, err := readTokenFile(tokenFile)
+	assert.Error(t, err)
+
+	assert.NoError(t, os.WriteFile(tokenFile, []byte(" \n"), 0o600))
+	_, err = readTokenFile(tokenFile)
+	assert.ErrorContains(t, err, "is empty")
+
+	assert.NoError(t, os.WriteFile(tokenFile, []byte("token\n"), 0o600))
+	token, err := readTokenFile(tokenFile)
+	assert.NoError(t, err)
+	assert.Equal(t, "token", token)
+}
@@ -3,6 +3,8 @@
 
 package provider
 
+import "github.com/hashicorp/terraform-plugin-framework/types"
+
 // EqualElements checks if two slices contain the same elements, regardless of order.
 func EqualElements[T comparable](s1, s2 []T) bool {
 	if len(s1) != len(s2) {
@@ -24,3 +26,12 @@ func EqualElements[T comparable](s1, s2 []T) bool {
 
 	return true
 }
+
+// reconcileMemberOf returns the group memberships for the state. StorageGRID does not keep the order of the groups,
+// therefore the prior memberships are kept as long as StorageGRID returns the same groups.
+func reconcileMemberOf(prior []types.String, memberOf []string) []types.String {
+	if prior != nil && EqualElements(toJson(prior), memberOf) {
+		return prior
+	}
+	return toTerraform(memberOf)
+}
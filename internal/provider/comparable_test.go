@@ -6,6 +6,7 @@ package provider
 import (
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -19,3 +20,12 @@ func Test_EqualElements(t *testing.T) {
 	assert.False(t, EqualElements(a, c))
 	assert.False(t, EqualElements(a, d))
 }
+
+func Test_reconcileMemberOf(t *testing.T) {
+	prior := []types.String{types.StringValue("group-b"), types.StringValue("group-a")}
+
+	// same groups in another order keep the prior order
+	assert.Equal(t, prior, reconcileMemberOf(prior, []string{"group-a", "group-b"}))
+	// changed groups are taken from StorageGRID
+	assert.Equal(t, []types.String{types.StringValue("group-a")}, reconcileMemberOf(prior, []string{"group-a"}))
+}
@@ -4,18 +4,39 @@
 package provider
 
 const (
-	act           = "action"
-	api_auth      = "/authorize"
-	api_buckets   = "/org/containers"
-	api_config    = "/org/config"
-	api_groups    = "/org/groups"
-	api_s3_suffix = "/s3-access-keys"
-	api_suffix    = "/api/v4"
-	api_users     = "/org/users"
-	fl_name       = "full_name"
-	id            = "id"
-	n_act         = "not_action"
-	n_res         = "not_resource"
-	res           = "resource"
-	unique_name   = "unique_name"
+	act                          = "action"
+	api_auth                     = "/authorize"
+	api_auth_saml                = "/authorize-saml"
+	api_buckets                  = "/org/containers"
+	api_compliance               = "/org/compliance-global"
+	api_config                   = "/org/config"
+	api_endpoints                = "/org/endpoints"
+	api_grid_accounts            = "/grid/accounts"
+	api_grid_client_certificates = "/grid/client-certificates"
+	api_grid_config              = "/grid/config"
+	api_grid_groups              = "/grid/groups"
+	api_grid_ha_groups           = "/private/ha-groups"
+	api_grid_ilm_policies        = "/grid/ilm-policies"
+	api_grid_ilm_rules           = "/grid/ilm-rules"
+	api_grid_lb_endpoints        = "/private/load-balancer-endpoints"
+	api_grid_server_config       = "/grid/server-config"
+	api_grid_storage_pools       = "/grid/storage-pools"
+	api_grid_traffic_classes     = "/grid/traffic-classes/policies"
+	api_grid_users               = "/grid/users"
+	api_groups                   = "/org/groups"
+	api_identity_source          = "/org/identity-source"
+	api_s3_suffix                = "/s3-access-keys"
+	api_saml_response            = "/api/saml-response"
+	api_suffix                   = "/api/v4"
+	api_usage                    = "/org/usage"
+	api_users                    = "/org/users"
+	fl_name                      = "full_name"
+	id                           = "id"
+	n_act                        = "not_action"
+	n_res                        = "not_resource"
+	res                          = "resource"
+	s3_default_region            = "us-east-1"
+	s3_xmlns                     = "http://s3.amazonaws.com/doc/2006-03-01/"
+	saml_grid_account_id         = "0"
+	unique_name                  = "unique_name"
 )
@@ -0,0 +1,420 @@
+// Copyright (c) github.com/dmpe
+// SPDX-License-Identifier: MIT
+
+package provider
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+const (
+	endpointAuthAnonymous = "anonymous"
+	endpointAuthAccessKey = "accessKey"
+	endpointAuthBasicHttp = "basicHttp"
+	endpointAuthCap       = "cap"
+
+	// the API accepts at most 500 endpoints per page
+	endpointsPageSize = 500
+)
+
+type EndpointResourceModel struct {
+	ID            types.String       `tfsdk:"id"`
+	DisplayName   types.String       `tfsdk:"display_name"`
+	EndpointURI   types.String       `tfsdk:"endpoint_uri"`
+	EndpointURN   types.String       `tfsdk:"endpoint_urn"`
+	CaCertificate types.String       `tfsdk:"ca_certificate"`
+	InsecureTLS   types.Bool         `tfsdk:"insecure_tls"`
+	ForceSave     types.Bool         `tfsdk:"force_save"`
+	Auth          *EndpointAuthModel `tfsdk:"auth"`
+}
+
+// EndpointAuthModel describes how StorageGRID authenticates against the endpoint. The secrets are write-only, because
+// the API obfuscates them in every response.
+type EndpointAuthModel struct {
+	Type                   types.String `tfsdk:"type"`
+	AccessKeyID            types.String `tfsdk:"access_key_id"`
+	SecretAccessKey        types.String `tfsdk:"secret_access_key_wo"`
+	Username               types.String `tfsdk:"username"`
+	Password               types.String `tfsdk:"password_wo"`
+	CapURL                 types.String `tfsdk:"cap_url"`
+	CapServerCaCertificate types.String `tfsdk:"cap_server_ca_certificate"`
+	CapClientCertificate   types.String `tfsdk:"cap_client_certificate"`
+	CapClientKey           types.String `tfsdk:"cap_client_key_wo"`
+	CapClientKeyPassphrase types.String `tfsdk:"cap_client_key_passphrase_wo"`
+	SecretsVersion         types.Int64  `tfsdk:"secrets_wo_version"`
+}
+
+type EndpointApiModel struct {
+	ID                   string                          `json:"id,omitempty"`
+	DisplayName          string                          `json:"displayName"`
+	EndpointURI          string                          `json:"endpointURI"`
+	EndpointURN          string                          `json:"endpointURN"`
+	AuthType             string                          `json:"authType"`
+	CaCert               string                          `json:"caCert,omitempty"`
+	InsecureTLS          bool                            `json:"insecureTLS"`
+	Credentials          *EndpointCredentialsApiModel    `json:"credentials,omitempty"`
+	BasicHttpCredentials *EndpointBasicHttpApiModel      `json:"basicHttpCredentials,omitempty"`
+	CapCredentials       *EndpointCapCredentialsApiModel `json:"capCredentials,omitempty"`
+	Error                *EndpointErrorApiModel          `json:"error,omitempty"`
+}
+
+type EndpointCredentialsApiModel struct {
+	AccessKeyID     string `json:"accessKeyId"`
+	SecretAccessKey string `json:"secretAccessKey"`
+}
+
+type EndpointBasicHttpApiModel struct {
+	Username string `json:"username"`
+	Password string `json:"password"`
+}
+
+type EndpointCapCredentialsApiModel struct {
+	URL                 string `json:"url"`
+	ServerCaCert        string `json:"serverCaCert,omitempty"`
+	ClientCert          string `json:"clientCert"`
+	ClientKey           string `json:"clientKey"`
+	ClientKeyPassphrase string `json:"clientKeyPassphrase,omitempty"`
+}
+
+type EndpointErrorApiModel struct {
+	Text string `json:"text"`
+	Time string `json:"time"`
+	Key  string `json:"key"`
+}
+
+func (m *EndpointResourceModel) authType() string {
+	if m.Auth == nil || m.Auth.Type.IsNull() {
+		return endpointAuthAnonymous
+	}
+	return m.Auth.Type.ValueString()
+}
+
+func (m *EndpointResourceModel) toEndpointApiModel() *EndpointApiModel {
+	endpoint := &EndpointApiModel{
+		ID:          m.ID.ValueString(),
+		DisplayName: m.DisplayName.ValueString(),
+		EndpointURI: m.EndpointURI.ValueString(),
+		EndpointURN: m.EndpointURN.ValueString(),
+		AuthType:    m.authType(),
+		CaCert:      m.CaCertificate.ValueString(),
+		InsecureTLS: m.InsecureTLS.ValueBool(),
+	}
+
+	switch endpoint.AuthType {
+	case endpointAuthAccessKey:
+		endpoint.Credentials = &EndpointCredentialsApiModel{
+			AccessKeyID:     m.Auth.AccessKeyID.ValueString(),
+			SecretAccessKey: m.Auth.SecretAccessKey.ValueString(),
+		}
+	case endpointAuthBasicHttp:
+		endpoint.BasicHttpCredentials = &EndpointBasicHttpApiModel{
+			Username: m.Auth.Username.ValueString(),
+			Password: m.Auth.Password.ValueString(),
+		}
+	case endpointAuthCap:
+		endpoint.CapCredentials = &EndpointCapCredentialsApiModel{
+			URL:                 m.Auth.CapURL.ValueString(),
+			ServerCaCert:        m.Auth.CapServerCaCertificate.ValueString(),
+			ClientCert:          m.Auth.CapClientCertificate.ValueString(),
+			ClientKey:           m.Auth.CapClientKey.ValueString(),
+			ClientKeyPassphrase: m.Auth.CapClientKeyPassphrase.ValueString(),
+		}
+	}
+
+	return endpoint
+}
+
+// withSecrets copies the write-only secrets of the configuration into the plan.
+func (m *EndpointResourceModel) withSecrets(config *EndpointResourceModel) {
+	if m.Auth == nil || config.Auth == nil {
+		return
+	}
+	m.Auth.SecretAccessKey = config.Auth.SecretAccessKey
+	m.Auth.Password = config.Auth.Password
+	m.Auth.CapClientKey = config.Auth.CapClientKey
+	m.Auth.CapClientKeyPassphrase = config.Auth.CapClientKeyPassphrase
+}
+
+// test asks StorageGRID to verify that it is able to connect to the endpoint, without saving it.
+func (m *EndpointResourceModel) test(client HttpClient) error {
+	method, endpoint := "POST", api_endpoints
+	if !m.ID.IsNull() && !m.ID.IsUnknown() {
+		method, endpoint = "PUT", fmt.Sprintf("%s/%s", api_endpoints, m.ID.ValueString())
+	}
+
+	_, _, respCode, err := client.SendRequest(method, endpoint+"?test=true", m.toEndpointApiModel(), 204)
+	if err != nil {
+		if respCode == http.StatusUnprocessableEntity {
+			return &GenericError{Summary: "Endpoint Test Failed", Details: fmt.Sprintf("StorageGRID is unable to use the endpoint '%s': %s", m.EndpointURN.ValueString(), err.Error()), Err: err}
+		}
+		return fmt.Errorf("unable to test endpoint: %w", err)
+	}
+
+	return nil
+}
+
+func (m *EndpointResourceModel) create(client HttpClient) (*EndpointResourceModel, error) {
+	if !m.ForceSave.ValueBool() {
+		if err := m.test(client); err != nil {
+			return nil, err
+		}
+	}
+
+	// the endpoint has been tested above unless the test is skipped on purpose, there is no need to test it again
+	respBody, _, _, err := client.SendRequest("POST", api_endpoints+"?forceSave=true", m.toEndpointApiModel(), 201)
+	if err != nil {
+		return nil, fmt.Errorf("unable to create endpoint: %w", err)
+	}
+
+	return NewEndpointResourceModel(respBody, m)
+}
+
+func (m *EndpointResourceModel) read(client HttpClient) (*EndpointResourceModel, error) {
+	endpoint := fmt.Sprintf("%s/%s", api_endpoints, m.ID.ValueString())
+	respBody, _, respCode, err := client.SendRequest("GET", endpoint, nil, 200)
+	if err != nil {
+		if respCode == http.StatusNotFound {
+			return nil, ErrEndpointNotFound
+		}
+		return nil, fmt.Errorf("unable to read endpoint: %w", err)
+	}
+
+	return NewEndpointResourceModel(respBody, m)
+}
+
+func (m *EndpointResourceModel) update(client HttpClient) (*EndpointResourceModel, error) {
+	if !m.ForceSave.ValueBool() {
+		if err := m.test(client); err != nil {
+			return nil, err
+		}
+	}
+
+	endpoint := fmt.Sprintf("%s/%s", api_endpoints, m.ID.ValueString())
+	respBody, _, respCode, err := client.SendRequest("PUT", endpoint+"?forceSave=true", m.toEndpointApiModel(), 200)
+	if err != nil {
+		if respCode == http.StatusNotFound {
+			return nil, ErrEndpointNotFound
+		}
+		return nil, fmt.Errorf("unable to update endpoint: %w", err)
+	}
+
+	return NewEndpointResourceModel(respBody, m)
+}
+
+func (m *EndpointResourceModel) delete(client HttpClient) error {
+	endpoint := fmt.Sprintf("%s/%s", api_endpoints, m.ID.ValueString())
+	_, _, respCode, err := client.SendRequest("DELETE", endpoint, nil, 204)
+	if err != nil {
+		if respCode == http.StatusNotFound {
+			return ErrEndpointNotFound
+		}
+		return fmt.Errorf("unable to delete endpoint: %w", err)
+	}
+
+	return nil
+}
+
+// validate checks the URN, and that the authentication type and its credentials match the service of the URN.
+func (m *EndpointResourceModel) validate() diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	service := ""
+	if !m.EndpointURN.IsUnknown() && !m.EndpointURN.IsNull() {
+		parts := strings.Split(m.EndpointURN.ValueString(), ":")
+		if len(parts) < 6 || (parts[0] != "arn" && parts[0] != "urn") || parts[2] == "" {
+			diags.AddAttributeError(
+				path.Root("endpoint_urn"),
+				"Invalid endpoint URN",
+				fmt.Sprintf("The URN '%s' must have the form 'arn:partition:service:region:account-id:resource' or 'urn:partition:service:region:account-id:resource', e.g. 'arn:aws:s3:::my-bucket'.", m.EndpointURN.ValueString()),
+			)
+		} else {
+			service = parts[2]
+		}
+	}
+
+	if m.Auth == nil || m.Auth.Type.IsUnknown() {
+		return diags
+	}
+
+	authType := m.Auth.Type.ValueString()
+	switch {
+	case authType == endpointAuthBasicHttp && service != "" && service != "es":
+		diags.AddAttributeError(
+			path.Root("auth").AtName("type"),
+			"Unsupported endpoint authentication",
+			fmt.Sprintf("Basic HTTP authentication is only supported for search integration (Elasticsearch) endpoints, but the URN refers to the service '%s'.", service),
+		)
+	case authType == endpointAuthCap && service != "" && service != "s3" && service != "sns":
+		diags.AddAttributeError(
+			path.Root("auth").AtName("type"),
+			"Unsupported endpoint authentication",
+			fmt.Sprintf("CAP (C2S Access Portal) authentication is only supported for S3 and SNS endpoints, but the URN refers to the service '%s'.", service),
+		)
+	}
+
+	credentials := []struct {
+		name     string
+		value    types.String
+		authType string
+		required bool
+	}{
+		{"access_key_id", m.Auth.AccessKeyID, endpointAuthAccessKey, true},
+		{"secret_access_key_wo", m.Auth.SecretAccessKey, endpointAuthAccessKey, true},
+		{"username", m.Auth.Username, endpointAuthBasicHttp, true},
+		{"password_wo", m.Auth.Password, endpointAuthBasicHttp, true},
+		{"cap_url", m.Auth.CapURL, endpointAuthCap, true},
+		{"cap_server_ca_certificate", m.Auth.CapServerCaCertificate, endpointAuthCap, false},
+		{"cap_client_certificate", m.Auth.CapClientCertificate, endpointAuthCap, true},
+		{"cap_client_key_wo", m.Auth.CapClientKey, endpointAuthCap, true},
+		{"cap_client_key_passphrase_wo", m.Auth.CapClientKeyPassphrase, endpointAuthCap, false},
+	}
+
+	for _, credential := range credentials {
+		if credential.authType == authType && credential.required && credential.value.IsNull() {
+			diags.AddAttributeError(
+				path.Root("auth").AtName(credential.name),
+				"Missing endpoint credential",
+				fmt.Sprintf("The attribute '%s' is required for the authentication type '%s'.", credential.name, authType),
+			)
+		}
+		if credential.authType != authType && !credential.value.IsNull() {
+			diags.AddAttributeError(
+				path.Root("auth").AtName(credential.name),
+				"Unexpected endpoint credential",
+				fmt.Sprintf("The attribute '%s' cannot be used with the authentication type '%s'.", credential.name, authType),
+			)
+		}
+	}
+
+	return diags
+}
+
+// NewEndpointResourceModel parses the JSON response from the API into an EndpointResourceModel. As the API obfuscates
+// all credentials, the access key ID and the version of the secrets are taken from the given prior model.
+func NewEndpointResourceModel(input []byte, prior *EndpointResourceModel) (*EndpointResourceModel, error) {
+	type responseDataType struct {
+		Data EndpointApiModel `json:"data"`
+	}
+
+	var returnBody responseDataType
+	if err := json.Unmarshal(input, &returnBody); err != nil {
+		return nil, &GenericError{Summary: "Client Error", Details: "Unable to parse endpoint response, got error: " + err.Error()}
+	}
+
+	model := newEndpointResourceModel(returnBody.Data, prior)
+	return &model, nil
+}
+
+func newEndpointResourceModel(endpoint EndpointApiModel, prior *EndpointResourceModel) EndpointResourceModel {
+	model := EndpointResourceModel{
+		ID:            types.StringValue(endpoint.ID),
+		DisplayName:   types.StringValue(endpoint.DisplayName),
+		EndpointURI:   types.StringValue(endpoint.EndpointURI),
+		EndpointURN:   types.StringValue(endpoint.EndpointURN),
+		CaCertificate: stringOrNull(endpoint.CaCert),
+		InsecureTLS:   types.BoolValue(endpoint.InsecureTLS),
+		ForceSave:     types.BoolValue(false),
+	}
+
+	var priorAuth *EndpointAuthModel
+	if prior != nil {
+		// force_save is not known to the API, an imported endpoint uses the default
+		if !prior.ForceSave.IsNull() {
+			model.ForceSave = prior.ForceSave
+		}
+		priorAuth = prior.Auth
+	}
+
+	// an anonymous endpoint may be configured without any auth attribute
+	if endpoint.AuthType == endpointAuthAnonymous && priorAuth == nil {
+		return model
+	}
+
+	model.Auth = &EndpointAuthModel{
+		Type:                   types.StringValue(endpoint.AuthType),
+		AccessKeyID:            types.StringNull(),
+		SecretAccessKey:        types.StringNull(),
+		Username:               types.StringNull(),
+		Password:               types.StringNull(),
+		CapURL:                 types.StringNull(),
+		CapServerCaCertificate: types.StringNull(),
+		CapClientCertificate:   types.StringNull(),
+		CapClientKey:           types.StringNull(),
+		CapClientKeyPassphrase: types.StringNull(),
+		SecretsVersion:         types.Int64Null(),
+	}
+
+	if priorAuth != nil {
+		model.Auth.SecretsVersion = priorAuth.SecretsVersion
+		if endpoint.AuthType == endpointAuthAccessKey {
+			model.Auth.AccessKeyID = priorAuth.AccessKeyID
+		}
+	}
+
+	if endpoint.BasicHttpCredentials != nil {
+		model.Auth.Username = stringOrNull(endpoint.BasicHttpCredentials.Username)
+	}
+
+	if endpoint.CapCredentials != nil {
+		model.Auth.CapURL = stringOrNull(endpoint.CapCredentials.URL)
+		model.Auth.CapServerCaCertificate = stringOrNull(endpoint.CapCredentials.ServerCaCert)
+		model.Auth.CapClientCertificate = stringOrNull(endpoint.CapCredentials.ClientCert)
+	}
+
+	return model
+}
+
+// readEndpoints returns all platform services endpoints configured for the tenant.
+func readEndpoints(client HttpClient) ([]EndpointApiModel, error) {
+	type endpointsReadModel struct {
+		Data []EndpointApiModel `json:"data"`
+	}
+
+	var endpoints []EndpointApiModel
+	marker := ""
+	for {
+		query := fmt.Sprintf("?limit=%d", endpointsPageSize)
+		if marker != "" {
+			query += "&marker=" + url.QueryEscape(marker)
+		}
+
+		respBody, _, _, err := client.SendRequest("GET", api_endpoints+query, nil, 200)
+		if err != nil {
+			return nil, fmt.Errorf("unable to read platform services endpoints: %w", err)
+		}
+
+		var returnBody endpointsReadModel
+		if err := json.Unmarshal(respBody, &returnBody); err != nil {
+			return nil, &GenericError{Summary: "Client Error", Details: "Unable to parse platform services endpoints response, got error: " + err.Error()}
+		}
+
+		endpoints = append(endpoints, returnBody.Data...)
+		if len(returnBody.Data) < endpointsPageSize {
+			return endpoints, nil
+		}
+		marker = returnBody.Data[len(returnBody.Data)-1].EndpointURN
+	}
+}
+
+// readEndpointURNs returns the URNs of all platform services endpoints configured for the tenant.
+func readEndpointURNs(client HttpClient) ([]string, error) {
+	endpoints, err := readEndpoints(client)
+	if err != nil {
+		return nil, err
+	}
+
+	urns := make([]string, len(endpoints))
+	for i, endpoint := range endpoints {
+		urns[i] = endpoint.EndpointURN
+	}
+
+	return urns, nil
+}
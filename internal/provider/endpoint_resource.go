@@ -0,0 +1,278 @@
+// Copyright (c) github.com/dmpe
+// SPDX-License-Identifier: MIT
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+// Ensure provider-defined types fully satisfy framework interfaces.
+var (
+	_ resource.Resource                   = &endpointResource{}
+	_ resource.ResourceWithConfigure      = &endpointResource{}
+	_ resource.ResourceWithImportState    = &endpointResource{}
+	_ resource.ResourceWithValidateConfig = &endpointResource{}
+)
+
+// NewEndpointResource returns a new resource instance.
+func NewEndpointResource() resource.Resource {
+	return &endpointResource{}
+}
+
+type endpointResource struct {
+	client *S3GridClient
+}
+
+func (r *endpointResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_endpoint"
+}
+
+func (r *endpointResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `
+Manage a platform services endpoint of the tenant. Endpoints are the destinations of bucket replication ('storagegrid_bucket_replication'), event notifications ('storagegrid_bucket_notification') and search integration ('storagegrid_bucket_metadata_notification').
+
+**Note:**
+- The tenant must be allowed to use platform services.
+- Unless 'force_save' is set, StorageGRID tests the connection to the endpoint before it is saved.
+- All secrets are write-only (Terraform 1.11 or later is required) and are never stored in the state. Increase 'auth.secrets_wo_version' to send changed secrets to StorageGRID.
+- As the API never returns secrets, an imported endpoint is updated once with the configured credentials.
+`,
+		Attributes: map[string]schema.Attribute{
+			id: schema.StringAttribute{
+				Computed:    true,
+				Description: "The unique identifier of the endpoint.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"display_name": schema.StringAttribute{
+				Required:    true,
+				Description: "The descriptive name of the endpoint, which does not need to be unique.",
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 32),
+				},
+			},
+			"endpoint_uri": schema.StringAttribute{
+				Required:    true,
+				Description: "The URL of the external resource, e.g. 'https://s3.us-east-1.amazonaws.com'.",
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 255),
+				},
+			},
+			"endpoint_urn": schema.StringAttribute{
+				Required:    true,
+				Description: "The URN of the external resource, e.g. 'arn:aws:s3:::my-bucket' or 'arn:aws:sns:us-east-1:123456789012:my-topic'. Changing the URN creates a new endpoint.",
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 255),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"ca_certificate": schema.StringAttribute{
+				Optional:    true,
+				Description: "A custom CA certificate (in PEM encoding) used to verify the connection to the endpoint.",
+			},
+			"insecure_tls": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Whether the TLS certificate of the endpoint is not verified. Defaults to 'false'.",
+				Default:     booldefault.StaticBool(false),
+			},
+			"force_save": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Save the endpoint without testing whether StorageGRID is able to connect to it. Defaults to 'false'.",
+				Default:     booldefault.StaticBool(false),
+			},
+			"auth": schema.SingleNestedAttribute{
+				Optional:    true,
+				Description: "How StorageGRID authenticates against the endpoint. Anonymous access is used if omitted.",
+				Attributes: map[string]schema.Attribute{
+					"type": schema.StringAttribute{
+						Required:    true,
+						Description: "The authentication type. Can be 'anonymous', 'accessKey', 'basicHttp' (only for search integration) or 'cap' (C2S Access Portal, only for S3 and SNS).",
+						Validators: []validator.String{
+							stringvalidator.OneOf(endpointAuthAnonymous, endpointAuthAccessKey, endpointAuthBasicHttp, endpointAuthCap),
+						},
+					},
+					"access_key_id": schema.StringAttribute{
+						Optional:    true,
+						Sensitive:   true,
+						Description: "The access key ID, required for the 'accessKey' authentication.",
+					},
+					"secret_access_key_wo": schema.StringAttribute{
+						Optional:    true,
+						Sensitive:   true,
+						WriteOnly:   true,
+						Description: "The secret access key, required for the 'accessKey' authentication.",
+					},
+					"username": schema.StringAttribute{
+						Optional:    true,
+						Description: "The username, required for the 'basicHttp' authentication.",
+					},
+					"password_wo": schema.StringAttribute{
+						Optional:    true,
+						Sensitive:   true,
+						WriteOnly:   true,
+						Description: "The password, required for the 'basicHttp' authentication.",
+					},
+					"cap_url": schema.StringAttribute{
+						Optional:    true,
+						Description: "The complete URL of the CAP credentials API, required for the 'cap' authentication.",
+					},
+					"cap_server_ca_certificate": schema.StringAttribute{
+						Optional:    true,
+						Description: "The CA certificates (in PEM encoding) used to verify the CAP server. The CA certificates of the operating system are used if omitted.",
+					},
+					"cap_client_certificate": schema.StringAttribute{
+						Optional:    true,
+						Description: "The client certificate (in PEM encoding) used to authenticate against the CAP server, required for the 'cap' authentication.",
+					},
+					"cap_client_key_wo": schema.StringAttribute{
+						Optional:    true,
+						Sensitive:   true,
+						WriteOnly:   true,
+						Description: "The private key (in PEM encoding) of the client certificate, required for the 'cap' authentication.",
+					},
+					"cap_client_key_passphrase_wo": schema.StringAttribute{
+						Optional:    true,
+						Sensitive:   true,
+						WriteOnly:   true,
+						Description: "The passphrase of the encrypted private key of the client certificate.",
+					},
+					"secrets_wo_version": schema.Int64Attribute{
+						Optional:    true,
+						Description: "An arbitrary version of the write-only secrets. Change it to send changed secrets to StorageGRID.",
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *endpointResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*S3GridClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	resp.Diagnostics.Append(requireTenantScope(client)...)
+
+	r.client = client
+}
+
+func (r *endpointResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config EndpointResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(config.validate()...)
+}
+
+func (r *endpointResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan, config EndpointResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan.withSecrets(&config)
+
+	endpoint, err := plan.create(r.client)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, endpoint)...)
+}
+
+func (r *endpointResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state EndpointResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	read, err := state.read(r.client)
+	if err != nil {
+		if errors.Is(err, ErrEndpointNotFound) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Error Reading StorageGrid platform services endpoint", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, read)...)
+}
+
+func (r *endpointResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, config EndpointResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan.withSecrets(&config)
+
+	endpoint, err := plan.update(r.client)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, endpoint)...)
+}
+
+func (r *endpointResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state EndpointResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := state.delete(r.client)
+	if err != nil && !errors.Is(err, ErrEndpointNotFound) {
+		resp.Diagnostics.AddError("Error Deleting StorageGrid platform services endpoint", err.Error())
+		return
+	}
+}
+
+func (r *endpointResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root(id), req, resp)
+}
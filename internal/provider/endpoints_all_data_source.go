@@ -0,0 +1,149 @@
+// Copyright (c) github.com/dmpe
+// SPDX-License-Identifier: MIT
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider-defined types fully satisfy framework interfaces.
+var (
+	_ datasource.DataSource              = &endpointsDataSource{}
+	_ datasource.DataSourceWithConfigure = &endpointsDataSource{}
+)
+
+// NewEndpointsDataSource returns a new data source instance.
+func NewEndpointsDataSource() datasource.DataSource {
+	return &endpointsDataSource{}
+}
+
+// endpointsDataSource defines the data source implementation.
+type endpointsDataSource struct {
+	client *S3GridClient
+}
+
+type EndpointsDataSourceModel struct {
+	Data []EndpointDataSourceModel `tfsdk:"data"`
+}
+
+type EndpointDataSourceModel struct {
+	ID            types.String `tfsdk:"id"`
+	DisplayName   types.String `tfsdk:"display_name"`
+	EndpointURI   types.String `tfsdk:"endpoint_uri"`
+	EndpointURN   types.String `tfsdk:"endpoint_urn"`
+	AuthType      types.String `tfsdk:"auth_type"`
+	CaCertificate types.String `tfsdk:"ca_certificate"`
+	InsecureTLS   types.Bool   `tfsdk:"insecure_tls"`
+	LastError     types.String `tfsdk:"last_error"`
+}
+
+func (d *endpointsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_endpoints"
+}
+
+func (d *endpointsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `
+Fetch all platform services endpoints of the tenant. Credentials are never returned by the API.
+`,
+		Attributes: map[string]schema.Attribute{
+			"data": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "The platform services endpoints of the tenant.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						id: schema.StringAttribute{
+							Computed:    true,
+							Description: "The unique identifier of the endpoint.",
+						},
+						"display_name": schema.StringAttribute{
+							Computed:    true,
+							Description: "The descriptive name of the endpoint.",
+						},
+						"endpoint_uri": schema.StringAttribute{
+							Computed:    true,
+							Description: "The URL of the external resource.",
+						},
+						"endpoint_urn": schema.StringAttribute{
+							Computed:    true,
+							Description: "The URN of the external resource.",
+						},
+						"auth_type": schema.StringAttribute{
+							Computed:    true,
+							Description: "The authentication type, either 'anonymous', 'accessKey', 'basicHttp' or 'cap'.",
+						},
+						"ca_certificate": schema.StringAttribute{
+							Computed:    true,
+							Description: "The custom CA certificate used to verify the connection to the endpoint.",
+						},
+						"insecure_tls": schema.BoolAttribute{
+							Computed:    true,
+							Description: "Whether the TLS certificate of the endpoint is not verified.",
+						},
+						"last_error": schema.StringAttribute{
+							Computed:    true,
+							Description: "The last error StorageGRID encountered when using the endpoint, if any.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *endpointsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*S3GridClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	resp.Diagnostics.Append(requireTenantScope(client)...)
+
+	d.client = client
+}
+
+func (d *endpointsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	endpoints, err := readEndpoints(d.client)
+	if err != nil {
+		resp.Diagnostics.AddError("Error Reading StorageGrid platform services endpoints", err.Error())
+		return
+	}
+
+	state := EndpointsDataSourceModel{Data: []EndpointDataSourceModel{}}
+	for _, endpoint := range endpoints {
+		model := EndpointDataSourceModel{
+			ID:            types.StringValue(endpoint.ID),
+			DisplayName:   types.StringValue(endpoint.DisplayName),
+			EndpointURI:   types.StringValue(endpoint.EndpointURI),
+			EndpointURN:   types.StringValue(endpoint.EndpointURN),
+			AuthType:      types.StringValue(endpoint.AuthType),
+			CaCertificate: stringOrNull(endpoint.CaCert),
+			InsecureTLS:   types.BoolValue(endpoint.InsecureTLS),
+			LastError:     types.StringNull(),
+		}
+		if endpoint.Error != nil {
+			model.LastError = stringOrNull(endpoint.Error.Text)
+		}
+		state.Data = append(state.Data, model)
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
@@ -9,6 +9,20 @@ import (
 )
 
 var ErrBucketNotFound = fmt.Errorf("bucket not found")
+var ErrEndpointNotFound = fmt.Errorf("endpoint not found")
+var ErrGridTenantNotFound = fmt.Errorf("tenant account not found")
+var ErrGridHAGroupNotFound = fmt.Errorf("HA group not found")
+var ErrGridLoadBalancerEndpointNotFound = fmt.Errorf("load balancer endpoint not found")
+var ErrGridStoragePoolNotFound = fmt.Errorf("storage pool not found")
+var ErrGridILMRuleNotFound = fmt.Errorf("ILM rule not found")
+var ErrGridILMPolicyNotFound = fmt.Errorf("ILM policy not found")
+var ErrGridTrafficClassNotFound = fmt.Errorf("traffic classification policy not found")
+var ErrGridUserNotFound = fmt.Errorf("grid user not found")
+var ErrGridGroupNotFound = fmt.Errorf("grid group not found")
+var ErrGridServerCertificateNotFound = fmt.Errorf("server certificate not found")
+var ErrGridClientCertificateNotFound = fmt.Errorf("client certificate not found")
+var ErrTokenNotRenewable = fmt.Errorf("a pre-issued token cannot be renewed")
+var ErrSamlResponseNotRenewable = fmt.Errorf("the SAML response of STORAGEGRID_SAML_RESPONSE can only be used once and cannot renew the token, set the saml_response_command to authenticate again")
 
 type GenericError struct {
 	Summary string
@@ -6,7 +6,11 @@ package provider
 import (
 	"errors"
 	"fmt"
+	"net/http"
 	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
 )
 
 func TestGenericErrorErrorString(t *testing.T) {
@@ -71,3 +75,113 @@ func TestGenericErrorIsThroughWrapping(t *testing.T) {
 		t.Fatalf("errors.Is should not match GenericError with a different summary through wrapping")
 	}
 }
+
+func TestResourceModel_NotFound(t *testing.T) {
+	// the resources map a 404 of StorageGRID to their sentinel error, which removes them from the state
+	tests := []struct {
+		name       string
+		operations func(client HttpClient) []error
+		sentinel   error
+	}{
+		{
+			name: "endpoint",
+			operations: func(client HttpClient) []error {
+				model := EndpointResourceModel{ID: types.StringValue("f4a2")}
+				_, err := model.read(client)
+				return []error{err}
+			},
+			sentinel: ErrEndpointNotFound,
+		},
+		{
+			name: "grid tenant",
+			operations: func(client HttpClient) []error {
+				model := GridTenantResourceModel{ID: types.StringValue("12345678901234567890")}
+				_, err := model.read(client)
+				return []error{err, model.delete(client)}
+			},
+			sentinel: ErrGridTenantNotFound,
+		},
+		{
+			name: "grid HA group",
+			operations: func(client HttpClient) []error {
+				model := GridHAGroupResourceModel{ID: types.StringValue("d8f1ab7c")}
+				_, err := model.read(client)
+				return []error{err, model.delete(client)}
+			},
+			sentinel: ErrGridHAGroupNotFound,
+		},
+		{
+			name: "grid storage pool",
+			operations: func(client HttpClient) []error {
+				model := GridStoragePoolResourceModel{ID: types.StringValue("4f2a09c1")}
+				_, err := model.read(client)
+				return []error{err, model.delete(client)}
+			},
+			sentinel: ErrGridStoragePoolNotFound,
+		},
+		{
+			name: "grid ILM rule",
+			operations: func(client HttpClient) []error {
+				model := GridILMRuleResourceModel{ID: types.StringValue("9c3e51aa")}
+				_, err := model.read(client)
+				return []error{err, model.delete(client)}
+			},
+			sentinel: ErrGridILMRuleNotFound,
+		},
+		{
+			name: "grid ILM policy",
+			operations: func(client HttpClient) []error {
+				model := GridILMPolicyResourceModel{ID: types.StringValue("b71e")}
+				_, err := model.read(client)
+				return []error{err, model.activate(client), model.delete(client)}
+			},
+			sentinel: ErrGridILMPolicyNotFound,
+		},
+		{
+			name: "grid traffic class",
+			operations: func(client HttpClient) []error {
+				model := GridTrafficClassResourceModel{ID: types.StringValue("5e7b2c90")}
+				_, err := model.read(client)
+				return []error{err, model.delete(client)}
+			},
+			sentinel: ErrGridTrafficClassNotFound,
+		},
+		{
+			name: "grid user",
+			operations: func(client HttpClient) []error {
+				model := GridUserResourceModel{ID: types.StringValue("00000000-0000-0000-0000-000000000001")}
+				_, err := model.read(client)
+				return []error{err, model.delete(client)}
+			},
+			sentinel: ErrGridUserNotFound,
+		},
+		{
+			name: "grid group",
+			operations: func(client HttpClient) []error {
+				model := GridGroupResourceModel{ID: types.StringValue("00000000-0000-0000-0000-000000000002")}
+				_, err := model.read(client)
+				return []error{err, model.delete(client)}
+			},
+			sentinel: ErrGridGroupNotFound,
+		},
+		{
+			name: "grid client certificate",
+			operations: func(client HttpClient) []error {
+				model := GridClientCertificateResourceModel{ID: types.StringValue("5f1a")}
+				_, err := model.read(client)
+				return []error{err, model.delete(client)}
+			},
+			sentinel: ErrGridClientCertificateNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			notFound := stubResponse{code: http.StatusNotFound, err: fmt.Errorf("unexpected status code")}
+			client := &stubHttpClient{responses: []stubResponse{notFound, notFound, notFound}}
+			for _, err := range tt.operations(client) {
+				assert.ErrorIs(t, err, tt.sentinel)
+			}
+		})
+	}
+}
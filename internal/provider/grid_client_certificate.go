@@ -0,0 +1,122 @@
+// Copyright (c) github.com/dmpe
+// SPDX-License-Identifier: MIT
+
+package provider
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type GridClientCertificateResourceModel struct {
+	ID                types.String `tfsdk:"id"`
+	DisplayName       types.String `tfsdk:"display_name"`
+	Certificate       types.String `tfsdk:"certificate"`
+	AllowPrometheus   types.Bool   `tfsdk:"allow_prometheus"`
+	ExpiryWarningDays types.Int64  `tfsdk:"expiry_warning_days"`
+	Fingerprint       types.String `tfsdk:"fingerprint"`
+	ExpiresAt         types.String `tfsdk:"expires_at"`
+}
+
+type GridClientCertificateApiModel struct {
+	ID              string `json:"id,omitempty"`
+	DisplayName     string `json:"displayName"`
+	PublicKey       string `json:"publicKey"`
+	AllowPrometheus bool   `json:"allowPrometheus"`
+}
+
+func (m *GridClientCertificateResourceModel) toGridClientCertificateApiModel() *GridClientCertificateApiModel {
+	return &GridClientCertificateApiModel{
+		ID:              m.ID.ValueString(),
+		DisplayName:     m.DisplayName.ValueString(),
+		PublicKey:       m.Certificate.ValueString(),
+		AllowPrometheus: m.AllowPrometheus.ValueBool(),
+	}
+}
+
+func (m *GridClientCertificateResourceModel) create(client HttpClient) (*GridClientCertificateResourceModel, error) {
+	respBody, _, _, err := client.SendRequest("POST", api_grid_client_certificates, m.toGridClientCertificateApiModel(), 201)
+	if err != nil {
+		return nil, fmt.Errorf("unable to create client certificate: %w", err)
+	}
+
+	return NewGridClientCertificateResourceModel(respBody, m)
+}
+
+func (m *GridClientCertificateResourceModel) read(client HttpClient) (*GridClientCertificateResourceModel, error) {
+	endpoint := fmt.Sprintf("%s/%s", api_grid_client_certificates, m.ID.ValueString())
+	respBody, _, respCode, err := client.SendRequest("GET", endpoint, nil, 200)
+	if err != nil {
+		if respCode == http.StatusNotFound {
+			return nil, ErrGridClientCertificateNotFound
+		}
+		return nil, fmt.Errorf("unable to read client certificate: %w", err)
+	}
+
+	return NewGridClientCertificateResourceModel(respBody, m)
+}
+
+func (m *GridClientCertificateResourceModel) update(client HttpClient) (*GridClientCertificateResourceModel, error) {
+	endpoint := fmt.Sprintf("%s/%s", api_grid_client_certificates, m.ID.ValueString())
+	respBody, _, respCode, err := client.SendRequest("PUT", endpoint, m.toGridClientCertificateApiModel(), 200)
+	if err != nil {
+		if respCode == http.StatusNotFound {
+			return nil, ErrGridClientCertificateNotFound
+		}
+		return nil, fmt.Errorf("unable to update client certificate: %w", err)
+	}
+
+	return NewGridClientCertificateResourceModel(respBody, m)
+}
+
+func (m *GridClientCertificateResourceModel) delete(client HttpClient) error {
+	endpoint := fmt.Sprintf("%s/%s", api_grid_client_certificates, m.ID.ValueString())
+	_, _, respCode, err := client.SendRequest("DELETE", endpoint, nil, 204)
+	if err != nil {
+		if respCode == http.StatusNotFound {
+			return ErrGridClientCertificateNotFound
+		}
+		return fmt.Errorf("unable to delete client certificate: %w", err)
+	}
+
+	return nil
+}
+
+// validate checks that the certificate is PEM encoded.
+func (m *GridClientCertificateResourceModel) validate() diag.Diagnostics {
+	return validateCertificate(path.Root("certificate"), m.Certificate, path.Empty(), types.StringNull())
+}
+
+// NewGridClientCertificateResourceModel parses the JSON response from the API into a
+// GridClientCertificateResourceModel. The expiry warning is taken from the given prior model.
+func NewGridClientCertificateResourceModel(input []byte, prior *GridClientCertificateResourceModel) (*GridClientCertificateResourceModel, error) {
+	type responseDataType struct {
+		Data GridClientCertificateApiModel `json:"data"`
+	}
+
+	var returnBody responseDataType
+	if err := json.Unmarshal(input, &returnBody); err != nil {
+		return nil, &GenericError{Summary: "Client Error", Details: "Unable to parse client certificate response, got error: " + err.Error()}
+	}
+
+	certificate := returnBody.Data
+	model := &GridClientCertificateResourceModel{
+		ID:                types.StringValue(certificate.ID),
+		DisplayName:       types.StringValue(certificate.DisplayName),
+		Certificate:       reconcileCertificate(prior.Certificate, certificate.PublicKey),
+		AllowPrometheus:   types.BoolValue(certificate.AllowPrometheus),
+		ExpiryWarningDays: prior.ExpiryWarningDays,
+	}
+	model.Fingerprint, model.ExpiresAt = certificateAttributes(certificate.PublicKey)
+
+	if model.ExpiryWarningDays.IsNull() {
+		model.ExpiryWarningDays = types.Int64Value(certificateExpiryWarningDays)
+	}
+
+	return model, nil
+}
@@ -0,0 +1,225 @@
+// Copyright (c) github.com/dmpe
+// SPDX-License-Identifier: MIT
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+// Ensure provider-defined types fully satisfy framework interfaces.
+var (
+	_ resource.Resource                   = &gridClientCertificateResource{}
+	_ resource.ResourceWithConfigure      = &gridClientCertificateResource{}
+	_ resource.ResourceWithImportState    = &gridClientCertificateResource{}
+	_ resource.ResourceWithModifyPlan     = &gridClientCertificateResource{}
+	_ resource.ResourceWithValidateConfig = &gridClientCertificateResource{}
+)
+
+// NewGridClientCertificateResource returns a new resource instance.
+func NewGridClientCertificateResource() resource.Resource {
+	return &gridClientCertificateResource{}
+}
+
+type gridClientCertificateResource struct {
+	client *S3GridClient
+}
+
+func (r *gridClientCertificateResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_grid_client_certificate"
+}
+
+func (r *gridClientCertificateResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `
+Manage a client certificate of the grid. External tools, e.g. for monitoring, authenticate with the certificate against StorageGRID.
+
+**Note:**
+- The provider must authenticate as grid administrator, i.e. with 'grid_admin = true'.
+- Only the certificate is uploaded, the private key stays with the external tool.
+- Every plan shows a warning once the certificate expires within 'expiry_warning_days'.
+- Import by the ID of the client certificate.
+`,
+		Attributes: map[string]schema.Attribute{
+			id: schema.StringAttribute{
+				Computed:    true,
+				Description: "The unique identifier of the client certificate.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"display_name": schema.StringAttribute{
+				Required:    true,
+				Description: "The name of the client certificate.",
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 64),
+				},
+			},
+			"certificate": schema.StringAttribute{
+				Required:    true,
+				Description: "The client certificate (in PEM encoding).",
+			},
+			"allow_prometheus": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Allow the holder of the certificate to query the Prometheus metrics of the grid. Defaults to 'false'.",
+				Default:     booldefault.StaticBool(false),
+			},
+			"expiry_warning_days": schema.Int64Attribute{
+				Optional:    true,
+				Computed:    true,
+				Description: fmt.Sprintf("The number of days before the expiry of the certificate, from which on every plan shows a warning. Defaults to '%d'.", certificateExpiryWarningDays),
+				Default:     int64default.StaticInt64(certificateExpiryWarningDays),
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+			"fingerprint": schema.StringAttribute{
+				Computed:    true,
+				Description: "The SHA-256 fingerprint of the certificate.",
+			},
+			"expires_at": schema.StringAttribute{
+				Computed:    true,
+				Description: "The expiry of the certificate in RFC 3339 format.",
+			},
+		},
+	}
+}
+
+func (r *gridClientCertificateResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*S3GridClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	resp.Diagnostics.Append(requireGridScope(client)...)
+
+	r.client = client
+}
+
+func (r *gridClientCertificateResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config GridClientCertificateResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(config.validate()...)
+}
+
+// ModifyPlan plans the fingerprint and the expiry of the configured certificate, and warns if it expires soon.
+func (r *gridClientCertificateResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// check if the resource is planned for destruction
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan GridClientCertificateResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(planCertificate(path.Root("certificate"), plan.Certificate, plan.ExpiryWarningDays, &plan.Fingerprint, &plan.ExpiresAt)...)
+	resp.Diagnostics.Append(resp.Plan.Set(ctx, plan)...)
+}
+
+func (r *gridClientCertificateResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan GridClientCertificateResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	certificate, err := plan.create(r.client)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, certificate)...)
+}
+
+func (r *gridClientCertificateResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state GridClientCertificateResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	read, err := state.read(r.client)
+	if err != nil {
+		if errors.Is(err, ErrGridClientCertificateNotFound) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Error Reading StorageGrid client certificate", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, read)...)
+}
+
+func (r *gridClientCertificateResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan GridClientCertificateResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	certificate, err := plan.update(r.client)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, certificate)...)
+}
+
+func (r *gridClientCertificateResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state GridClientCertificateResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := state.delete(r.client)
+	if err != nil && !errors.Is(err, ErrGridClientCertificateNotFound) {
+		resp.Diagnostics.AddError("Error Deleting StorageGrid client certificate", err.Error())
+		return
+	}
+}
+
+func (r *gridClientCertificateResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root(id), req, resp)
+}
@@ -0,0 +1,66 @@
+// Copyright (c) github.com/dmpe
+// SPDX-License-Identifier: MIT
+
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestGridClientCertificateResource(t *testing.T) {
+	gridProvider := gridProviderConfiguration(t)
+	certificate, _ := newTestCertificate(t, time.Now().AddDate(1, 0, 0))
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"storagegrid": providerserver.NewProtocol6WithError(New("test")()),
+		},
+		Steps: []resource.TestStep{
+			// Not PEM encoded
+			{
+				Config:      gridClientCertificateConfiguration(gridProvider, "not a certificate", false),
+				ExpectError: regexp.MustCompile("Invalid certificate"),
+			},
+			// Create
+			{
+				Config: gridClientCertificateConfiguration(gridProvider, certificate, false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("storagegrid_grid_client_certificate.test", "id"),
+					resource.TestCheckResourceAttr("storagegrid_grid_client_certificate.test", "allow_prometheus", "false"),
+					resource.TestCheckResourceAttrSet("storagegrid_grid_client_certificate.test", "fingerprint"),
+					resource.TestCheckResourceAttrSet("storagegrid_grid_client_certificate.test", "expires_at"),
+				),
+			},
+			// Update
+			{
+				Config: gridClientCertificateConfiguration(gridProvider, certificate, true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("storagegrid_grid_client_certificate.test", "allow_prometheus", "true"),
+				),
+			},
+			// Import
+			{
+				ResourceName:      "storagegrid_grid_client_certificate.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Delete testing is done automatically
+		},
+	})
+}
+
+func gridClientCertificateConfiguration(gridProvider string, certificate string, allowPrometheus bool) string {
+	return gridProvider + fmt.Sprintf(`
+resource "storagegrid_grid_client_certificate" "test" {
+	display_name     = "tf-provider-acc-test"
+	certificate      = %q
+	allow_prometheus = %t
+}`, certificate, allowPrometheus)
+}
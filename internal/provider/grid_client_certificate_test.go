@@ -0,0 +1,41 @@
+// Copyright (c) github.com/dmpe
+// SPDX-License-Identifier: MIT
+
+package provider
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGridClientCertificateResourceModel_Create(t *testing.T) {
+	certificate, _ := newTestCertificate(t, time.Date(2030, 1, 2, 3, 4, 5, 0, time.UTC))
+	model := GridClientCertificateResourceModel{
+		ID:                types.StringUnknown(),
+		DisplayName:       types.StringValue("tf-provider-acc-test"),
+		Certificate:       types.StringValue(certificate),
+		AllowPrometheus:   types.BoolValue(true),
+		ExpiryWarningDays: types.Int64Null(),
+		Fingerprint:       types.StringUnknown(),
+		ExpiresAt:         types.StringUnknown(),
+	}
+
+	encoded, _ := json.Marshal(certificate)
+	client := &stubHttpClient{responses: []stubResponse{
+		{body: fmt.Sprintf(`{"data": {"id": "5f1a", "displayName": "tf-provider-acc-test", "publicKey": %s, "allowPrometheus": true}}`, encoded), code: 201},
+	}}
+
+	created, err := model.create(client)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"POST /grid/client-certificates"}, client.requests)
+	assert.Equal(t, &GridClientCertificateApiModel{DisplayName: "tf-provider-acc-test", PublicKey: certificate, AllowPrometheus: true}, client.payloads[0])
+
+	assert.Equal(t, "5f1a", created.ID.ValueString())
+	assert.Equal(t, "2030-01-02T03:04:05Z", created.ExpiresAt.ValueString())
+	assert.Equal(t, int64(certificateExpiryWarningDays), created.ExpiryWarningDays.ValueInt64())
+}
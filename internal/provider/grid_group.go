@@ -0,0 +1,160 @@
+// Copyright (c) github.com/dmpe
+// SPDX-License-Identifier: MIT
+
+package provider
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// gridPermissions maps the grid permissions of the resource to the management policy of the API.
+var gridPermissions = map[string]string{
+	"activate-features":           "activateFeatures",
+	"alarm-acknowledgement":       "alarmAcknowledgement",
+	"change-tenant-root-password": "changeTenantRootPassword",
+	"grid-topology-configuration": "gridTopologyConfiguration",
+	"ilm":                         "ilm",
+	"maintenance":                 "maintenance",
+	"manage-alerts":               "manageAlerts",
+	"metrics-query":               "metricsQuery",
+	"object-metadata":             "objectMetadata",
+	"other-grid-configuration":    "otherGridConfiguration",
+	"root-access":                 "rootAccess",
+	"storage-admin":               "storageAdmin",
+	"tenant-accounts":             "tenantAccounts",
+}
+
+type GridGroupResourceModel struct {
+	ID                 types.String   `tfsdk:"id"`
+	UniqueName         types.String   `tfsdk:"unique_name"`
+	DisplayName        types.String   `tfsdk:"display_name"`
+	ManagementReadOnly types.Bool     `tfsdk:"management_read_only"`
+	Permissions        []types.String `tfsdk:"permissions"`
+	Federated          types.Bool     `tfsdk:"federated"`
+	GroupURN           types.String   `tfsdk:"group_urn"`
+}
+
+type GridGroupApiModel struct {
+	ID                 string                    `json:"id,omitempty"`
+	UniqueName         string                    `json:"uniqueName"`
+	DisplayName        string                    `json:"displayName"`
+	ManagementReadOnly bool                      `json:"managementReadOnly"`
+	Policies           GridGroupPoliciesApiModel `json:"policies"`
+	Federated          bool                      `json:"federated,omitempty"`
+	GroupURN           string                    `json:"groupURN,omitempty"`
+}
+
+type GridGroupPoliciesApiModel struct {
+	Management map[string]bool `json:"management"`
+}
+
+func (m *GridGroupResourceModel) toGridGroupApiModel() *GridGroupApiModel {
+	group := &GridGroupApiModel{
+		ID:                 m.ID.ValueString(),
+		UniqueName:         m.UniqueName.ValueString(),
+		DisplayName:        m.DisplayName.ValueString(),
+		ManagementReadOnly: m.ManagementReadOnly.ValueBool(),
+		Policies:           GridGroupPoliciesApiModel{Management: map[string]bool{}},
+	}
+
+	for _, permission := range m.Permissions {
+		group.Policies.Management[gridPermissions[permission.ValueString()]] = true
+	}
+
+	return group
+}
+
+func (m *GridGroupResourceModel) create(client HttpClient) (*GridGroupResourceModel, error) {
+	respBody, _, _, err := client.SendRequest("POST", api_grid_groups, m.toGridGroupApiModel(), 201)
+	if err != nil {
+		return nil, fmt.Errorf("unable to create grid group: %w", err)
+	}
+
+	return NewGridGroupResourceModel(respBody)
+}
+
+func (m *GridGroupResourceModel) read(client HttpClient) (*GridGroupResourceModel, error) {
+	endpoint := fmt.Sprintf("%s/%s", api_grid_groups, m.ID.ValueString())
+	respBody, _, respCode, err := client.SendRequest("GET", endpoint, nil, 200)
+	if err != nil {
+		if respCode == http.StatusNotFound {
+			return nil, ErrGridGroupNotFound
+		}
+		return nil, fmt.Errorf("unable to read grid group: %w", err)
+	}
+
+	return NewGridGroupResourceModel(respBody)
+}
+
+func (m *GridGroupResourceModel) update(client HttpClient) (*GridGroupResourceModel, error) {
+	endpoint := fmt.Sprintf("%s/%s", api_grid_groups, m.ID.ValueString())
+	respBody, _, respCode, err := client.SendRequest("PUT", endpoint, m.toGridGroupApiModel(), 200)
+	if err != nil {
+		if respCode == http.StatusNotFound {
+			return nil, ErrGridGroupNotFound
+		}
+		return nil, fmt.Errorf("unable to update grid group: %w", err)
+	}
+
+	return NewGridGroupResourceModel(respBody)
+}
+
+func (m *GridGroupResourceModel) delete(client HttpClient) error {
+	endpoint := fmt.Sprintf("%s/%s", api_grid_groups, m.ID.ValueString())
+	_, _, respCode, err := client.SendRequest("DELETE", endpoint, nil, 204)
+	if err != nil {
+		if respCode == http.StatusNotFound {
+			return ErrGridGroupNotFound
+		}
+		return fmt.Errorf("unable to delete grid group: %w", err)
+	}
+
+	return nil
+}
+
+// NewGridGroupResourceModel parses the JSON response from the API into a GridGroupResourceModel. Only the granted
+// permissions known to the provider are returned, sorted by name.
+func NewGridGroupResourceModel(input []byte) (*GridGroupResourceModel, error) {
+	type responseDataType struct {
+		Data GridGroupApiModel `json:"data"`
+	}
+
+	var returnBody responseDataType
+	if err := json.Unmarshal(input, &returnBody); err != nil {
+		return nil, &GenericError{Summary: "Client Error", Details: "Unable to parse grid group response, got error: " + err.Error()}
+	}
+
+	group := returnBody.Data
+	var permissions []string
+	for permission, apiPermission := range gridPermissions {
+		if group.Policies.Management[apiPermission] {
+			permissions = append(permissions, permission)
+		}
+	}
+	sort.Strings(permissions)
+
+	return &GridGroupResourceModel{
+		ID:                 types.StringValue(group.ID),
+		UniqueName:         types.StringValue(group.UniqueName),
+		DisplayName:        types.StringValue(group.DisplayName),
+		ManagementReadOnly: types.BoolValue(group.ManagementReadOnly),
+		Permissions:        toTerraformOrNull(permissions),
+		Federated:          types.BoolValue(group.Federated),
+		GroupURN:           types.StringValue(group.GroupURN),
+	}, nil
+}
+
+// gridPermissionNames returns the names of the grid permissions of the resource, sorted by name.
+func gridPermissionNames() []string {
+	names := make([]string, 0, len(gridPermissions))
+	for name := range gridPermissions {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
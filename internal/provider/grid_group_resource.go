@@ -0,0 +1,206 @@
+// Copyright (c) github.com/dmpe
+// SPDX-License-Identifier: MIT
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider-defined types fully satisfy framework interfaces.
+var (
+	_ resource.Resource                = &gridGroupResource{}
+	_ resource.ResourceWithConfigure   = &gridGroupResource{}
+	_ resource.ResourceWithImportState = &gridGroupResource{}
+)
+
+// NewGridGroupResource returns a new resource instance.
+func NewGridGroupResource() resource.Resource {
+	return &gridGroupResource{}
+}
+
+type gridGroupResource struct {
+	client *S3GridClient
+}
+
+func (r *gridGroupResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_grid_group"
+}
+
+func (r *gridGroupResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `
+Manage a group of grid administrators and its grid permissions. Use 'storagegrid_groups' for the groups of a tenant.
+
+**Note:**
+- The provider must authenticate as grid administrator, i.e. with 'grid_admin = true'.
+- The unique name cannot be changed, a change replaces the group.
+- Import by the ID of the group.
+`,
+		Attributes: map[string]schema.Attribute{
+			id: schema.StringAttribute{
+				Computed:    true,
+				Description: "The unique identifier of the group.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			unique_name: schema.StringAttribute{
+				Required: true,
+				Description: "The unique name of the group, e.g. 'group/ilm-admins' for a local group. " +
+					"For a federated group, e.g. 'federated-group/ilm-admins', the name comes from the identity source.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"display_name": schema.StringAttribute{
+				Required:    true,
+				Description: "The human-readable name of the group.",
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 32),
+				},
+			},
+			"management_read_only": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Whether the users of the group can only view settings and features, instead of changing them. Defaults to 'false'.",
+				Default:     booldefault.StaticBool(false),
+			},
+			"permissions": schema.SetAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+				Description: "The grid permissions of the group. Can be " + strings.Join(gridPermissionNames(), ", ") + ". " +
+					"The permission 'root-access' supersedes all other permissions.",
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+					setvalidator.ValueStringsAre(stringvalidator.OneOf(gridPermissionNames()...)),
+				},
+			},
+			"federated": schema.BoolAttribute{
+				Computed:    true,
+				Description: "True if the group is federated, for example, an LDAP group.",
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"group_urn": schema.StringAttribute{
+				Computed:    true,
+				Description: "The URN of the group.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *gridGroupResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*S3GridClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	resp.Diagnostics.Append(requireGridScope(client)...)
+
+	r.client = client
+}
+
+func (r *gridGroupResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan GridGroupResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	group, err := plan.create(r.client)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, group)...)
+}
+
+func (r *gridGroupResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state GridGroupResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	read, err := state.read(r.client)
+	if err != nil {
+		if errors.Is(err, ErrGridGroupNotFound) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Error Reading StorageGrid grid group", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, read)...)
+}
+
+func (r *gridGroupResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan GridGroupResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	group, err := plan.update(r.client)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, group)...)
+}
+
+func (r *gridGroupResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state GridGroupResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := state.delete(r.client)
+	if err != nil && !errors.Is(err, ErrGridGroupNotFound) {
+		resp.Diagnostics.AddError("Error Deleting StorageGrid grid group", err.Error())
+		return
+	}
+}
+
+func (r *gridGroupResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root(id), req, resp)
+}
@@ -0,0 +1,65 @@
+// Copyright (c) github.com/dmpe
+// SPDX-License-Identifier: MIT
+
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestGridGroupResource(t *testing.T) {
+	gridProvider := gridProviderConfiguration(t)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"storagegrid": providerserver.NewProtocol6WithError(New("test")()),
+		},
+		Steps: []resource.TestStep{
+			// Unknown permission
+			{
+				Config:      gridGroupConfiguration(gridProvider, `"ilm", "s3"`),
+				ExpectError: regexp.MustCompile(`value must be one of`),
+			},
+			// Create
+			{
+				Config: gridGroupConfiguration(gridProvider, `"ilm"`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("storagegrid_grid_group.test", "id"),
+					resource.TestCheckResourceAttrSet("storagegrid_grid_group.test", "group_urn"),
+					resource.TestCheckResourceAttr("storagegrid_grid_group.test", "management_read_only", "false"),
+					resource.TestCheckResourceAttr("storagegrid_grid_group.test", "federated", "false"),
+					resource.TestCheckResourceAttr("storagegrid_grid_group.test", "permissions.#", "1"),
+				),
+			},
+			// Update
+			{
+				Config: gridGroupConfiguration(gridProvider, `"ilm", "tenant-accounts"`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("storagegrid_grid_group.test", "permissions.#", "2"),
+				),
+			},
+			// Import
+			{
+				ResourceName:      "storagegrid_grid_group.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Delete testing is done automatically
+		},
+	})
+}
+
+func gridGroupConfiguration(gridProvider string, permissions string) string {
+	return gridProvider + fmt.Sprintf(`
+resource "storagegrid_grid_group" "test" {
+	unique_name  = "group/tf-provider-acc-test"
+	display_name = "tf-provider-acc-test"
+	permissions  = [%s]
+}`, permissions)
+}
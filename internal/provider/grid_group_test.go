@@ -0,0 +1,51 @@
+// Copyright (c) github.com/dmpe
+// SPDX-License-Identifier: MIT
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+)
+
+const gridGroupResponse = `{"data": {
+	"id": "00000000-0000-0000-0000-000000000002", "accountId": "0", "uniqueName": "group/tf-provider-acc-test",
+	"displayName": "Terraform", "managementReadOnly": true, "federated": false,
+	"groupURN": "urn:sgws:identity::0:group/tf-provider-acc-test",
+	"policies": {"management": {"tenantAccounts": true, "ilm": true, "maintenance": false, "unknownPermission": true}}
+}}`
+
+func TestGridGroupResourceModel_Create(t *testing.T) {
+	model := GridGroupResourceModel{
+		ID:                 types.StringUnknown(),
+		UniqueName:         types.StringValue("group/tf-provider-acc-test"),
+		DisplayName:        types.StringValue("Terraform"),
+		ManagementReadOnly: types.BoolValue(true),
+		Permissions:        []types.String{types.StringValue("tenant-accounts"), types.StringValue("ilm")},
+		Federated:          types.BoolUnknown(),
+		GroupURN:           types.StringUnknown(),
+	}
+
+	client := &stubHttpClient{responses: []stubResponse{
+		{body: gridGroupResponse, code: 201},
+	}}
+
+	group, err := model.create(client)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"POST /grid/groups"}, client.requests)
+	assert.Equal(t, map[string]bool{"tenantAccounts": true, "ilm": true}, client.payloads[0].(*GridGroupApiModel).Policies.Management)
+
+	// unknown and revoked permissions are not returned
+	assert.Equal(t, []types.String{types.StringValue("ilm"), types.StringValue("tenant-accounts")}, group.Permissions)
+	assert.Equal(t, "00000000-0000-0000-0000-000000000002", group.ID.ValueString())
+	assert.True(t, group.ManagementReadOnly.ValueBool())
+}
+
+func TestGridGroupResourceModel_WithoutPermissions(t *testing.T) {
+	group, err := NewGridGroupResourceModel([]byte(`{"data": {"id": "00000000-0000-0000-0000-000000000002",
+		"uniqueName": "group/tf-provider-acc-test", "displayName": "Terraform", "policies": {"management": {}}}}`))
+	assert.NoError(t, err)
+	assert.Nil(t, group.Permissions)
+}
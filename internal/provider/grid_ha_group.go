@@ -0,0 +1,194 @@
+// Copyright (c) github.com/dmpe
+// SPDX-License-Identifier: MIT
+
+package provider
+
+import (
+	"encoding/json"
+	"fmt"
+	"net"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type GridHAGroupResourceModel struct {
+	ID                    types.String             `tfsdk:"id"`
+	Name                  types.String             `tfsdk:"name"`
+	Description           types.String             `tfsdk:"description"`
+	VirtualIPs            []types.String           `tfsdk:"virtual_ips"`
+	GatewayIP             types.String             `tfsdk:"gateway_ip"`
+	Interfaces            []GridNodeInterfaceModel `tfsdk:"interfaces"`
+	PreferredMasterNodeID types.String             `tfsdk:"preferred_master_node_id"`
+}
+
+// GridNodeInterfaceModel references a network interface of a grid node, e.g. the interface 'eth2' of a gateway node.
+type GridNodeInterfaceModel struct {
+	NodeID    types.String `tfsdk:"node_id"`
+	Interface types.String `tfsdk:"interface"`
+}
+
+type GridHAGroupApiModel struct {
+	ID          string                      `json:"id,omitempty"`
+	Name        string                      `json:"name"`
+	Description string                      `json:"description,omitempty"`
+	VirtualIPs  []string                    `json:"virtualIps"`
+	GatewayIP   string                      `json:"gatewayIp,omitempty"`
+	Interfaces  []GridNodeInterfaceApiModel `json:"interfaces"`
+}
+
+type GridNodeInterfaceApiModel struct {
+	NodeID    string `json:"nodeId"`
+	Interface string `json:"interface"`
+}
+
+func toGridNodeInterfaceApiModels(in []GridNodeInterfaceModel) []GridNodeInterfaceApiModel {
+	out := make([]GridNodeInterfaceApiModel, len(in))
+	for i, v := range in {
+		out[i] = GridNodeInterfaceApiModel{NodeID: v.NodeID.ValueString(), Interface: v.Interface.ValueString()}
+	}
+	return out
+}
+
+func toGridNodeInterfaceModels(in []GridNodeInterfaceApiModel) []GridNodeInterfaceModel {
+	out := make([]GridNodeInterfaceModel, len(in))
+	for i, v := range in {
+		out[i] = GridNodeInterfaceModel{NodeID: types.StringValue(v.NodeID), Interface: types.StringValue(v.Interface)}
+	}
+	return out
+}
+
+func (m *GridHAGroupResourceModel) toGridHAGroupApiModel() *GridHAGroupApiModel {
+	return &GridHAGroupApiModel{
+		ID:          m.ID.ValueString(),
+		Name:        m.Name.ValueString(),
+		Description: m.Description.ValueString(),
+		VirtualIPs:  toJson(m.VirtualIPs),
+		GatewayIP:   m.GatewayIP.ValueString(),
+		Interfaces:  toGridNodeInterfaceApiModels(m.Interfaces),
+	}
+}
+
+func (m *GridHAGroupResourceModel) create(client HttpClient) (*GridHAGroupResourceModel, error) {
+	respBody, _, _, err := client.SendRequest("POST", api_grid_ha_groups, m.toGridHAGroupApiModel(), 201)
+	if err != nil {
+		return nil, fmt.Errorf("unable to create HA group: %w", err)
+	}
+
+	return NewGridHAGroupResourceModel(respBody)
+}
+
+func (m *GridHAGroupResourceModel) read(client HttpClient) (*GridHAGroupResourceModel, error) {
+	endpoint := fmt.Sprintf("%s/%s", api_grid_ha_groups, m.ID.ValueString())
+	respBody, _, respCode, err := client.SendRequest("GET", endpoint, nil, 200)
+	if err != nil {
+		if respCode == http.StatusNotFound {
+			return nil, ErrGridHAGroupNotFound
+		}
+		return nil, fmt.Errorf("unable to read HA group: %w", err)
+	}
+
+	return NewGridHAGroupResourceModel(respBody)
+}
+
+func (m *GridHAGroupResourceModel) update(client HttpClient) (*GridHAGroupResourceModel, error) {
+	endpoint := fmt.Sprintf("%s/%s", api_grid_ha_groups, m.ID.ValueString())
+	respBody, _, respCode, err := client.SendRequest("PUT", endpoint, m.toGridHAGroupApiModel(), 200)
+	if err != nil {
+		if respCode == http.StatusNotFound {
+			return nil, ErrGridHAGroupNotFound
+		}
+		return nil, fmt.Errorf("unable to update HA group: %w", err)
+	}
+
+	return NewGridHAGroupResourceModel(respBody)
+}
+
+func (m *GridHAGroupResourceModel) delete(client HttpClient) error {
+	endpoint := fmt.Sprintf("%s/%s", api_grid_ha_groups, m.ID.ValueString())
+	_, _, respCode, err := client.SendRequest("DELETE", endpoint, nil, 204)
+	if err != nil {
+		if respCode == http.StatusNotFound {
+			return ErrGridHAGroupNotFound
+		}
+		return fmt.Errorf("unable to delete HA group: %w", err)
+	}
+
+	return nil
+}
+
+// NewGridHAGroupResourceModel parses the JSON response from the API into a GridHAGroupResourceModel. The API orders
+// the interfaces by preference, the first one is the preferred master.
+func NewGridHAGroupResourceModel(input []byte) (*GridHAGroupResourceModel, error) {
+	type responseDataType struct {
+		Data GridHAGroupApiModel `json:"data"`
+	}
+
+	var returnBody responseDataType
+	if err := json.Unmarshal(input, &returnBody); err != nil {
+		return nil, &GenericError{Summary: "Client Error", Details: "Unable to parse HA group response, got error: " + err.Error()}
+	}
+
+	group := returnBody.Data
+	model := &GridHAGroupResourceModel{
+		ID:                    types.StringValue(group.ID),
+		Name:                  types.StringValue(group.Name),
+		Description:           stringOrNull(group.Description),
+		VirtualIPs:            toTerraform(group.VirtualIPs),
+		GatewayIP:             stringOrNull(group.GatewayIP),
+		Interfaces:            toGridNodeInterfaceModels(group.Interfaces),
+		PreferredMasterNodeID: types.StringNull(),
+	}
+
+	if len(group.Interfaces) > 0 {
+		model.PreferredMasterNodeID = types.StringValue(group.Interfaces[0].NodeID)
+	}
+
+	return model, nil
+}
+
+// validate checks the virtual and gateway IP addresses, and that no node interface is used twice.
+func (m *GridHAGroupResourceModel) validate() diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	for i, vip := range m.VirtualIPs {
+		if vip.IsUnknown() || vip.IsNull() {
+			continue
+		}
+		if net.ParseIP(vip.ValueString()) == nil {
+			diags.AddAttributeError(
+				path.Root("virtual_ips").AtListIndex(i),
+				"Invalid virtual IP address",
+				fmt.Sprintf("'%s' is not an IPv4 or IPv6 address.", vip.ValueString()),
+			)
+		}
+	}
+
+	if !m.GatewayIP.IsUnknown() && !m.GatewayIP.IsNull() && net.ParseIP(m.GatewayIP.ValueString()) == nil {
+		diags.AddAttributeError(
+			path.Root("gateway_ip"),
+			"Invalid gateway IP address",
+			fmt.Sprintf("'%s' is not an IPv4 or IPv6 address.", m.GatewayIP.ValueString()),
+		)
+	}
+
+	seen := map[GridNodeInterfaceApiModel]bool{}
+	for i, iface := range m.Interfaces {
+		if iface.NodeID.IsUnknown() || iface.Interface.IsUnknown() {
+			continue
+		}
+		key := GridNodeInterfaceApiModel{NodeID: iface.NodeID.ValueString(), Interface: iface.Interface.ValueString()}
+		if seen[key] {
+			diags.AddAttributeError(
+				path.Root("interfaces").AtListIndex(i),
+				"Duplicate HA group interface",
+				fmt.Sprintf("The interface '%s' of the node '%s' is listed more than once.", key.Interface, key.NodeID),
+			)
+		}
+		seen[key] = true
+	}
+
+	return diags
+}
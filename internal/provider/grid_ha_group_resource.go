@@ -0,0 +1,220 @@
+// Copyright (c) github.com/dmpe
+// SPDX-License-Identifier: MIT
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider-defined types fully satisfy framework interfaces.
+var (
+	_ resource.Resource                   = &gridHAGroupResource{}
+	_ resource.ResourceWithConfigure      = &gridHAGroupResource{}
+	_ resource.ResourceWithImportState    = &gridHAGroupResource{}
+	_ resource.ResourceWithValidateConfig = &gridHAGroupResource{}
+)
+
+// NewGridHAGroupResource returns a new resource instance.
+func NewGridHAGroupResource() resource.Resource {
+	return &gridHAGroupResource{}
+}
+
+type gridHAGroupResource struct {
+	client *S3GridClient
+}
+
+func (r *gridHAGroupResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_grid_ha_group"
+}
+
+func (r *gridHAGroupResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `
+Manage a high availability (HA) group of the grid. An HA group provides virtual IP addresses (VIPs) on the network interfaces of several gateway or admin nodes, one of them is active at a time.
+
+**Note:**
+- The provider must authenticate as grid administrator, i.e. with 'grid_admin = true'.
+- The order of the interfaces is significant: the first interface is the preferred master, the others are backups in the given order.
+- Import by the ID of the HA group.
+`,
+		Attributes: map[string]schema.Attribute{
+			id: schema.StringAttribute{
+				Computed:    true,
+				Description: "The unique identifier of the HA group.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Required:    true,
+				Description: "The name of the HA group.",
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 64),
+				},
+			},
+			"description": schema.StringAttribute{
+				Optional:    true,
+				Description: "The description of the HA group.",
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 64),
+				},
+			},
+			"virtual_ips": schema.ListAttribute{
+				ElementType: types.StringType,
+				Required:    true,
+				Description: "The virtual IP addresses of the HA group. At most 10 addresses are allowed.",
+				Validators: []validator.List{
+					listvalidator.SizeBetween(1, 10),
+					listvalidator.UniqueValues(),
+				},
+			},
+			"gateway_ip": schema.StringAttribute{
+				Optional:    true,
+				Description: "The IP address of the gateway of the virtual IP addresses, if they are in another subnet than the interfaces.",
+			},
+			"interfaces": schema.ListNestedAttribute{
+				Required:    true,
+				Description: "The network interfaces of the nodes which hold the virtual IP addresses. The first interface is the preferred master.",
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+				},
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"node_id": schema.StringAttribute{
+							Required:    true,
+							Description: "The ID of the gateway or admin node.",
+						},
+						"interface": schema.StringAttribute{
+							Required:    true,
+							Description: "The name of the network interface of the node, e.g. 'eth0'.",
+						},
+					},
+				},
+			},
+			"preferred_master_node_id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The ID of the node of the first interface, which is the preferred master.",
+			},
+		},
+	}
+}
+
+func (r *gridHAGroupResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*S3GridClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	resp.Diagnostics.Append(requireGridScope(client)...)
+
+	r.client = client
+}
+
+func (r *gridHAGroupResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config GridHAGroupResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(config.validate()...)
+}
+
+func (r *gridHAGroupResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan GridHAGroupResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	group, err := plan.create(r.client)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, group)...)
+}
+
+func (r *gridHAGroupResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state GridHAGroupResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	read, err := state.read(r.client)
+	if err != nil {
+		if errors.Is(err, ErrGridHAGroupNotFound) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Error Reading StorageGrid HA group", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, read)...)
+}
+
+func (r *gridHAGroupResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan GridHAGroupResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	group, err := plan.update(r.client)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, group)...)
+}
+
+func (r *gridHAGroupResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state GridHAGroupResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := state.delete(r.client)
+	if err != nil && !errors.Is(err, ErrGridHAGroupNotFound) {
+		resp.Diagnostics.AddError("Error Deleting StorageGrid HA group", err.Error())
+		return
+	}
+}
+
+func (r *gridHAGroupResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root(id), req, resp)
+}
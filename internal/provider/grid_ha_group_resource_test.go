@@ -0,0 +1,72 @@
+// Copyright (c) github.com/dmpe
+// SPDX-License-Identifier: MIT
+
+package provider
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestGridHAGroupResource(t *testing.T) {
+	gridProvider := gridProviderConfiguration(t)
+
+	nodeID := os.Getenv("STORAGEGRID_GRID_NODE_ID")
+	virtualIP := os.Getenv("STORAGEGRID_GRID_VIRTUAL_IP")
+	if nodeID == "" || virtualIP == "" {
+		t.Skip("STORAGEGRID_GRID_NODE_ID and STORAGEGRID_GRID_VIRTUAL_IP must be set to test HA groups")
+	}
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"storagegrid": providerserver.NewProtocol6WithError(New("test")()),
+		},
+		Steps: []resource.TestStep{
+			// Create
+			{
+				Config: gridHAGroupConfiguration(gridProvider, nodeID, virtualIP, "tf-provider-acc-test"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("storagegrid_grid_ha_group.test", "id"),
+					resource.TestCheckResourceAttr("storagegrid_grid_ha_group.test", "virtual_ips.0", virtualIP),
+					resource.TestCheckResourceAttr("storagegrid_grid_ha_group.test", "interfaces.0.node_id", nodeID),
+					resource.TestCheckResourceAttr("storagegrid_grid_ha_group.test", "preferred_master_node_id", nodeID),
+				),
+			},
+			// Update
+			{
+				Config: gridHAGroupConfiguration(gridProvider, nodeID, virtualIP, "tf-provider-acc-test-2"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("storagegrid_grid_ha_group.test", "description", "tf-provider-acc-test-2"),
+				),
+			},
+			// Import
+			{
+				ResourceName:      "storagegrid_grid_ha_group.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Delete testing is done automatically
+		},
+	})
+}
+
+func gridHAGroupConfiguration(gridProvider string, nodeID string, virtualIP string, description string) string {
+	return gridProvider + fmt.Sprintf(`
+resource "storagegrid_grid_ha_group" "test" {
+	name        = "tf-provider-acc-test"
+	description = "%s"
+	virtual_ips = ["%s"]
+
+	interfaces = [
+		{
+			node_id   = "%s"
+			interface = "eth0"
+		},
+	]
+}`, description, virtualIP, nodeID)
+}
@@ -0,0 +1,89 @@
+// Copyright (c) github.com/dmpe
+// SPDX-License-Identifier: MIT
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+)
+
+const gridHAGroupResponse = `{"data": {
+	"id": "d8f1ab7c", "name": "tf-provider-acc-test", "virtualIps": ["10.0.0.10"], "gatewayIp": "",
+	"interfaces": [{"nodeId": "node-b", "interface": "eth2"}, {"nodeId": "node-a", "interface": "eth2"}]
+}}`
+
+func newTestGridHAGroupResourceModel() GridHAGroupResourceModel {
+	return GridHAGroupResourceModel{
+		ID:          types.StringUnknown(),
+		Name:        types.StringValue("tf-provider-acc-test"),
+		Description: types.StringNull(),
+		VirtualIPs:  []types.String{types.StringValue("10.0.0.10")},
+		GatewayIP:   types.StringNull(),
+		Interfaces: []GridNodeInterfaceModel{
+			{NodeID: types.StringValue("node-b"), Interface: types.StringValue("eth2")},
+			{NodeID: types.StringValue("node-a"), Interface: types.StringValue("eth2")},
+		},
+		PreferredMasterNodeID: types.StringUnknown(),
+	}
+}
+
+func TestGridHAGroupResourceModel_Create(t *testing.T) {
+	model := newTestGridHAGroupResourceModel()
+
+	client := &stubHttpClient{responses: []stubResponse{
+		{body: gridHAGroupResponse, code: 201},
+	}}
+
+	group, err := model.create(client)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"POST /private/ha-groups"}, client.requests)
+
+	// the order of the interfaces is the preference
+	payload := client.payloads[0].(*GridHAGroupApiModel)
+	assert.Equal(t, []GridNodeInterfaceApiModel{{NodeID: "node-b", Interface: "eth2"}, {NodeID: "node-a", Interface: "eth2"}}, payload.Interfaces)
+
+	assert.Equal(t, "d8f1ab7c", group.ID.ValueString())
+	assert.Equal(t, "node-b", group.PreferredMasterNodeID.ValueString())
+	assert.True(t, group.GatewayIP.IsNull())
+	assert.True(t, group.Description.IsNull())
+}
+
+func TestGridHAGroupResourceModel_Validate(t *testing.T) {
+	valid := newTestGridHAGroupResourceModel()
+	valid.GatewayIP = types.StringValue("10.0.0.1")
+
+	invalidIPs := newTestGridHAGroupResourceModel()
+	invalidIPs.VirtualIPs = []types.String{types.StringValue("10.0.0.300")}
+	invalidIPs.GatewayIP = types.StringValue("gateway")
+
+	duplicateInterface := newTestGridHAGroupResourceModel()
+	duplicateInterface.Interfaces = append(duplicateInterface.Interfaces, duplicateInterface.Interfaces[0])
+
+	unknown := newTestGridHAGroupResourceModel()
+	unknown.VirtualIPs = []types.String{types.StringUnknown()}
+	unknown.GatewayIP = types.StringUnknown()
+
+	tests := []struct {
+		name   string
+		model  GridHAGroupResourceModel
+		errors []string
+	}{
+		{"valid", valid, nil},
+		{"invalid IP addresses", invalidIPs, []string{"Invalid virtual IP address", "Invalid gateway IP address"}},
+		{"duplicate interface", duplicateInterface, []string{"Duplicate HA group interface"}},
+		{"unknown values", unknown, nil},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var summaries []string
+			for _, d := range test.model.validate() {
+				summaries = append(summaries, d.Summary())
+			}
+			assert.Equal(t, test.errors, summaries)
+		})
+	}
+}
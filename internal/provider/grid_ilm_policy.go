@@ -0,0 +1,244 @@
+// Copyright (c) github.com/dmpe
+// SPDX-License-Identifier: MIT
+
+package provider
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+const (
+	ilmPolicyStatusProposed   = "proposed"
+	ilmPolicyStatusActive     = "active"
+	ilmPolicyStatusHistorical = "historical"
+)
+
+type GridILMPolicyResourceModel struct {
+	ID            types.String   `tfsdk:"id"`
+	Name          types.String   `tfsdk:"name"`
+	Reason        types.String   `tfsdk:"reason"`
+	RuleIDs       []types.String `tfsdk:"rule_ids"`
+	DefaultRuleID types.String   `tfsdk:"default_rule_id"`
+	Activate      types.Bool     `tfsdk:"activate"`
+	Status        types.String   `tfsdk:"status"`
+}
+
+type GridILMPolicyApiModel struct {
+	ID          string   `json:"id,omitempty"`
+	Name        string   `json:"name"`
+	Reason      string   `json:"reason,omitempty"`
+	Rules       []string `json:"rules"`
+	DefaultRule string   `json:"defaultRule"`
+	Status      string   `json:"status,omitempty"`
+}
+
+func (m *GridILMPolicyResourceModel) toGridILMPolicyApiModel() *GridILMPolicyApiModel {
+	return &GridILMPolicyApiModel{
+		ID:          m.ID.ValueString(),
+		Name:        m.Name.ValueString(),
+		Reason:      m.Reason.ValueString(),
+		Rules:       toJson(m.RuleIDs),
+		DefaultRule: m.DefaultRuleID.ValueString(),
+	}
+}
+
+// create creates the policy as proposed policy, and activates it if requested.
+func (m *GridILMPolicyResourceModel) create(client HttpClient) (*GridILMPolicyResourceModel, error) {
+	respBody, _, _, err := client.SendRequest("POST", api_grid_ilm_policies, m.toGridILMPolicyApiModel(), 201)
+	if err != nil {
+		return nil, fmt.Errorf("unable to create ILM policy: %w", err)
+	}
+
+	policy, err := NewGridILMPolicyResourceModel(respBody, nil)
+	if err != nil || !m.Activate.ValueBool() {
+		return policy, err
+	}
+
+	if err := policy.activate(client); err != nil {
+		return nil, err
+	}
+
+	return policy.read(client)
+}
+
+func (m *GridILMPolicyResourceModel) read(client HttpClient) (*GridILMPolicyResourceModel, error) {
+	endpoint := fmt.Sprintf("%s/%s", api_grid_ilm_policies, m.ID.ValueString())
+	respBody, _, respCode, err := client.SendRequest("GET", endpoint, nil, 200)
+	if err != nil {
+		if respCode == http.StatusNotFound {
+			return nil, ErrGridILMPolicyNotFound
+		}
+		return nil, fmt.Errorf("unable to read ILM policy: %w", err)
+	}
+
+	return NewGridILMPolicyResourceModel(respBody, m)
+}
+
+// update changes the proposed policy, and activates it if requested. StorageGRID does not allow to change an active or
+// historical policy, see validateChange.
+func (m *GridILMPolicyResourceModel) update(client HttpClient, prior *GridILMPolicyResourceModel) (*GridILMPolicyResourceModel, error) {
+	if prior.Status.ValueString() == ilmPolicyStatusProposed {
+		endpoint := fmt.Sprintf("%s/%s", api_grid_ilm_policies, m.ID.ValueString())
+		_, _, respCode, err := client.SendRequest("PUT", endpoint, m.toGridILMPolicyApiModel(), 200)
+		if err != nil {
+			if respCode == http.StatusNotFound {
+				return nil, ErrGridILMPolicyNotFound
+			}
+			return nil, fmt.Errorf("unable to update ILM policy: %w", err)
+		}
+
+		if m.Activate.ValueBool() {
+			if err := m.activate(client); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	return m.read(client)
+}
+
+// activate makes the proposed policy the active policy. The previously active policy becomes historical.
+func (m *GridILMPolicyResourceModel) activate(client HttpClient) error {
+	endpoint := fmt.Sprintf("%s/%s/activate", api_grid_ilm_policies, m.ID.ValueString())
+	_, _, respCode, err := client.SendRequest("POST", endpoint, nil, 204)
+	if err != nil {
+		if respCode == http.StatusNotFound {
+			return ErrGridILMPolicyNotFound
+		}
+		return fmt.Errorf("unable to activate ILM policy: %w", err)
+	}
+
+	return nil
+}
+
+func (m *GridILMPolicyResourceModel) delete(client HttpClient) error {
+	endpoint := fmt.Sprintf("%s/%s", api_grid_ilm_policies, m.ID.ValueString())
+	_, _, respCode, err := client.SendRequest("DELETE", endpoint, nil, 204)
+	if err != nil {
+		if respCode == http.StatusNotFound {
+			return ErrGridILMPolicyNotFound
+		}
+		return fmt.Errorf("unable to delete ILM policy: %w", err)
+	}
+
+	return nil
+}
+
+// NewGridILMPolicyResourceModel parses the JSON response from the API into a GridILMPolicyResourceModel. A policy is
+// activated if it is the active policy of the grid. Once the prior model, or the plan, knows that the policy is not
+// proposed anymore, the policy keeps the prior activation: activating another policy makes this one historical, which must not show up
+// as a diff of its configuration.
+func NewGridILMPolicyResourceModel(input []byte, prior *GridILMPolicyResourceModel) (*GridILMPolicyResourceModel, error) {
+	type responseDataType struct {
+		Data GridILMPolicyApiModel `json:"data"`
+	}
+
+	var returnBody responseDataType
+	if err := json.Unmarshal(input, &returnBody); err != nil {
+		return nil, &GenericError{Summary: "Client Error", Details: "Unable to parse ILM policy response, got error: " + err.Error()}
+	}
+
+	policy := returnBody.Data
+
+	activate := types.BoolValue(policy.Status == ilmPolicyStatusActive)
+	if policy.Status != ilmPolicyStatusProposed && prior != nil && !prior.Activate.IsNull() && !prior.Activate.IsUnknown() &&
+		!prior.Status.IsNull() && !prior.Status.Equal(types.StringValue(ilmPolicyStatusProposed)) {
+		activate = prior.Activate
+	}
+
+	return &GridILMPolicyResourceModel{
+		ID:            types.StringValue(policy.ID),
+		Name:          types.StringValue(policy.Name),
+		Reason:        stringOrNull(policy.Reason),
+		RuleIDs:       toTerraform(policy.Rules),
+		DefaultRuleID: types.StringValue(policy.DefaultRule),
+		Activate:      activate,
+		Status:        types.StringValue(policy.Status),
+	}, nil
+}
+
+// validate checks that the default rule is not one of the other rules of the policy.
+func (m *GridILMPolicyResourceModel) validate() diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if m.DefaultRuleID.IsUnknown() {
+		return diags
+	}
+
+	for i, ruleID := range m.RuleIDs {
+		if !ruleID.IsUnknown() && ruleID.Equal(m.DefaultRuleID) {
+			diags.AddAttributeError(
+				path.Root("rule_ids").AtListIndex(i),
+				"Duplicate ILM policy rule",
+				fmt.Sprintf("The default rule '%s' is always evaluated last, it must not be listed in 'rule_ids'.", ruleID.ValueString()),
+			)
+		}
+	}
+
+	return diags
+}
+
+// validateChange checks the planned policy against the lifecycle of the prior policy, which is nil for a new policy:
+// only a proposed policy can be changed or activated. A change of the activation of an active or historical policy has
+// no effect, StorageGRID only deactivates a policy when another one is activated.
+func (m *GridILMPolicyResourceModel) validateChange(prior *GridILMPolicyResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	status := ilmPolicyStatusProposed
+	if prior != nil {
+		status = prior.Status.ValueString()
+	}
+
+	if status == ilmPolicyStatusProposed {
+		if m.Activate.ValueBool() {
+			diags.AddAttributeWarning(
+				path.Root("activate"),
+				"ILM policy will be activated",
+				fmt.Sprintf("The ILM policy '%s' will replace the active ILM policy of the grid. StorageGRID evaluates all "+
+					"existing objects against the new policy, which may move or delete object copies.", m.Name.ValueString()),
+			)
+		}
+		return diags
+	}
+
+	changed := !m.Name.Equal(prior.Name) || !m.Reason.Equal(prior.Reason) || !m.DefaultRuleID.Equal(prior.DefaultRuleID) ||
+		!slices.Equal(m.RuleIDs, prior.RuleIDs)
+	if changed {
+		diags.AddError(
+			"ILM policy cannot be changed",
+			fmt.Sprintf("The ILM policy '%s' is %s and cannot be changed anymore. Create a new policy and activate it instead.",
+				prior.Name.ValueString(), status),
+		)
+	}
+
+	if m.Activate.IsUnknown() || m.Activate.Equal(prior.Activate) {
+		return diags
+	}
+
+	if status == ilmPolicyStatusActive && !m.Activate.ValueBool() {
+		diags.AddAttributeWarning(
+			path.Root("activate"),
+			"ILM policy stays active",
+			fmt.Sprintf("The grid always has an active ILM policy. The ILM policy '%s' stays active until another policy is "+
+				"activated, it then becomes historical.", prior.Name.ValueString()),
+		)
+	}
+
+	if status == ilmPolicyStatusHistorical && m.Activate.ValueBool() {
+		diags.AddAttributeWarning(
+			path.Root("activate"),
+			"ILM policy stays historical",
+			fmt.Sprintf("The ILM policy '%s' is historical, another policy has been activated after it. It cannot be "+
+				"activated anymore, create a new policy and activate it instead.", prior.Name.ValueString()),
+		)
+	}
+
+	return diags
+}
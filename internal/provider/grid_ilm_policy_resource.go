@@ -0,0 +1,268 @@
+// Copyright (c) github.com/dmpe
+// SPDX-License-Identifier: MIT
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider-defined types fully satisfy framework interfaces.
+var (
+	_ resource.Resource                   = &gridILMPolicyResource{}
+	_ resource.ResourceWithConfigure      = &gridILMPolicyResource{}
+	_ resource.ResourceWithImportState    = &gridILMPolicyResource{}
+	_ resource.ResourceWithModifyPlan     = &gridILMPolicyResource{}
+	_ resource.ResourceWithValidateConfig = &gridILMPolicyResource{}
+)
+
+// NewGridILMPolicyResource returns a new resource instance.
+func NewGridILMPolicyResource() resource.Resource {
+	return &gridILMPolicyResource{}
+}
+
+type gridILMPolicyResource struct {
+	client *S3GridClient
+}
+
+func (r *gridILMPolicyResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_grid_ilm_policy"
+}
+
+func (r *gridILMPolicyResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `
+Manage an information lifecycle management (ILM) policy of the grid. The active ILM policy decides with its ordered ILM rules ('storagegrid_grid_ilm_rule') how all objects of the grid are stored.
+
+**Note:**
+- The provider must authenticate as grid administrator, i.e. with 'grid_admin = true'.
+- A new policy is created as proposed policy. It only takes effect once it is activated with 'activate = true', which replaces the active policy of the grid. The plan shows a warning before a policy is activated.
+- Only a proposed policy can be changed. To change an active policy, create a new policy and activate it. The previously active policy becomes historical, and keeps 'activate = true' without a diff.
+- An active policy cannot be deactivated, activate another policy instead. Setting 'activate = false' only shows a warning, as does activating a historical policy.
+- Active and historical policies are kept by StorageGRID. Destroying them only removes them from the Terraform state.
+- Import by the ID of the ILM policy.
+`,
+		Attributes: map[string]schema.Attribute{
+			id: schema.StringAttribute{
+				Computed:    true,
+				Description: "The unique identifier of the ILM policy.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Required:    true,
+				Description: "The name of the ILM policy.",
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 64),
+				},
+			},
+			"reason": schema.StringAttribute{
+				Optional:    true,
+				Description: "The reason for the change of the ILM policy, which is shown in the audit log and the policy history.",
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 128),
+				},
+			},
+			"rule_ids": schema.ListAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+				Description: "The IDs of the ILM rules of the policy, e.g. from 'storagegrid_grid_ilm_rule'. The rules are evaluated in this order, the first matching rule applies to an object.",
+				Validators: []validator.List{
+					listvalidator.UniqueValues(),
+				},
+			},
+			"default_rule_id": schema.StringAttribute{
+				Required:    true,
+				Description: "The ID of the default ILM rule, which applies to all objects not matched by another rule. The default rule must not have a filter.",
+			},
+			"activate": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Whether the policy is the active policy of the grid. Setting it to 'true' activates the proposed policy. Defaults to 'false'.",
+				Default:     booldefault.StaticBool(false),
+			},
+			"status": schema.StringAttribute{
+				Computed:    true,
+				Description: "The status of the ILM policy: 'proposed', 'active' or 'historical'.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *gridILMPolicyResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*S3GridClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	resp.Diagnostics.Append(requireGridScope(client)...)
+
+	r.client = client
+}
+
+func (r *gridILMPolicyResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config GridILMPolicyResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(config.validate()...)
+}
+
+// ModifyPlan makes the lifecycle of the policy visible in the plan: it warns before a policy is activated, rejects
+// changes which StorageGRID does not allow for active or historical policies, and warns about changes of their
+// activation, which have no effect.
+func (r *gridILMPolicyResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	var prior *GridILMPolicyResourceModel
+	if !req.State.Raw.IsNull() {
+		prior = &GridILMPolicyResourceModel{}
+		resp.Diagnostics.Append(req.State.Get(ctx, prior)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	// check if the resource is planned for destruction
+	if req.Plan.Raw.IsNull() {
+		if prior != nil && prior.Status.ValueString() != ilmPolicyStatusProposed {
+			resp.Diagnostics.AddWarning(
+				"Resource Destruction Considerations",
+				fmt.Sprintf("The ILM policy '%s' is %s and is kept by StorageGRID. Applying this resource destruction will only "+
+					"remove it from the Terraform state.", prior.Name.ValueString(), prior.Status.ValueString()),
+			)
+		}
+		return
+	}
+
+	var plan GridILMPolicyResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(plan.validateChange(prior)...)
+
+	if prior != nil && prior.Status.ValueString() != ilmPolicyStatusProposed {
+		// StorageGRID decides the status of an active or historical policy, which may change with the activation of
+		// another policy in the same apply
+		if !plan.Activate.Equal(prior.Activate) {
+			resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("status"), types.StringUnknown())...)
+		}
+	} else if plan.Activate.IsUnknown() {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("status"), types.StringUnknown())...)
+	} else if plan.Activate.ValueBool() {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("status"), types.StringValue(ilmPolicyStatusActive))...)
+	} else if prior == nil {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("status"), types.StringValue(ilmPolicyStatusProposed))...)
+	}
+}
+
+func (r *gridILMPolicyResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan GridILMPolicyResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	policy, err := plan.create(r.client)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, policy)...)
+}
+
+func (r *gridILMPolicyResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state GridILMPolicyResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	read, err := state.read(r.client)
+	if err != nil {
+		if errors.Is(err, ErrGridILMPolicyNotFound) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Error Reading StorageGrid ILM policy", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, read)...)
+}
+
+func (r *gridILMPolicyResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state GridILMPolicyResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	policy, err := plan.update(r.client, &state)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, policy)...)
+}
+
+func (r *gridILMPolicyResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state GridILMPolicyResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// active and historical policies are kept by StorageGRID, they are only removed from the state
+	if state.Status.ValueString() != ilmPolicyStatusProposed {
+		return
+	}
+
+	err := state.delete(r.client)
+	if err != nil && !errors.Is(err, ErrGridILMPolicyNotFound) {
+		resp.Diagnostics.AddError("Error Deleting StorageGrid ILM policy", err.Error())
+		return
+	}
+}
+
+func (r *gridILMPolicyResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root(id), req, resp)
+}
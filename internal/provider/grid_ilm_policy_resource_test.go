@@ -0,0 +1,77 @@
+// Copyright (c) github.com/dmpe
+// SPDX-License-Identifier: MIT
+
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+// TestGridILMPolicyResource only tests proposed policies, activating a policy would change the ILM of the test grid.
+func TestGridILMPolicyResource(t *testing.T) {
+	gridProvider := gridProviderConfiguration(t)
+	siteID := gridSiteID(t)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"storagegrid": providerserver.NewProtocol6WithError(New("test")()),
+		},
+		Steps: []resource.TestStep{
+			// Create
+			{
+				Config: gridILMPolicyConfiguration(gridProvider, siteID, "tf-provider-acc-test"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("storagegrid_grid_ilm_policy.test", "id"),
+					resource.TestCheckResourceAttr("storagegrid_grid_ilm_policy.test", "status", "proposed"),
+					resource.TestCheckResourceAttr("storagegrid_grid_ilm_policy.test", "activate", "false"),
+					resource.TestCheckResourceAttrPair("storagegrid_grid_ilm_policy.test", "rule_ids.0", "storagegrid_grid_ilm_rule.test", "id"),
+				),
+			},
+			// Update
+			{
+				Config: gridILMPolicyConfiguration(gridProvider, siteID, "tf-provider-acc-test-2"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("storagegrid_grid_ilm_policy.test", "reason", "tf-provider-acc-test-2"),
+				),
+			},
+			// Import
+			{
+				ResourceName:      "storagegrid_grid_ilm_policy.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Delete testing is done automatically
+		},
+	})
+}
+
+func gridILMPolicyConfiguration(gridProvider string, siteID string, reason string) string {
+	return gridILMRuleConfiguration(gridProvider, siteID, "balanced") + fmt.Sprintf(`
+resource "storagegrid_grid_ilm_rule" "default" {
+	name = "tf-provider-acc-test-default"
+
+	placements = [
+		{
+			start_day = 0
+			copies = [
+				{
+					storage_pool_id = storagegrid_grid_storage_pool.test.id
+					count           = 2
+				},
+			]
+		},
+	]
+}
+
+resource "storagegrid_grid_ilm_policy" "test" {
+	name            = "tf-provider-acc-test"
+	reason          = "%s"
+	rule_ids        = [storagegrid_grid_ilm_rule.test.id]
+	default_rule_id = storagegrid_grid_ilm_rule.default.id
+}`, reason)
+}
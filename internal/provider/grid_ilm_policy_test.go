@@ -0,0 +1,211 @@
+// Copyright (c) github.com/dmpe
+// SPDX-License-Identifier: MIT
+
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func gridILMPolicyResponse(status string) string {
+	return fmt.Sprintf(`{"data": {
+	"id": "b71d40e2", "name": "tf-provider-acc-test", "rules": ["9c3e51aa"], "defaultRule": "1", "status": "%s"
+}}`, status)
+}
+
+func newTestGridILMPolicyResourceModel() GridILMPolicyResourceModel {
+	return GridILMPolicyResourceModel{
+		ID:            types.StringValue("b71d40e2"),
+		Name:          types.StringValue("tf-provider-acc-test"),
+		Reason:        types.StringNull(),
+		RuleIDs:       []types.String{types.StringValue("9c3e51aa")},
+		DefaultRuleID: types.StringValue("1"),
+		Activate:      types.BoolValue(false),
+		Status:        types.StringValue(ilmPolicyStatusProposed),
+	}
+}
+
+func TestGridILMPolicyResourceModel_Create(t *testing.T) {
+	model := newTestGridILMPolicyResourceModel()
+	model.ID = types.StringUnknown()
+
+	client := &stubHttpClient{responses: []stubResponse{
+		{body: gridILMPolicyResponse(ilmPolicyStatusProposed), code: 201},
+	}}
+
+	policy, err := model.create(client)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"POST /grid/ilm-policies"}, client.requests)
+	assert.Equal(t, []string{"9c3e51aa"}, client.payloads[0].(*GridILMPolicyApiModel).Rules)
+	assert.False(t, policy.Activate.ValueBool())
+	assert.Equal(t, ilmPolicyStatusProposed, policy.Status.ValueString())
+}
+
+func TestGridILMPolicyResourceModel_CreateActivated(t *testing.T) {
+	model := newTestGridILMPolicyResourceModel()
+	model.ID = types.StringUnknown()
+	model.Activate = types.BoolValue(true)
+
+	client := &stubHttpClient{responses: []stubResponse{
+		{body: gridILMPolicyResponse(ilmPolicyStatusProposed), code: 201},
+		{code: 204},
+		{body: gridILMPolicyResponse(ilmPolicyStatusActive), code: 200},
+	}}
+
+	policy, err := model.create(client)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{
+		"POST /grid/ilm-policies",
+		"POST /grid/ilm-policies/b71d40e2/activate",
+		"GET /grid/ilm-policies/b71d40e2",
+	}, client.requests)
+	assert.True(t, policy.Activate.ValueBool())
+	assert.Equal(t, ilmPolicyStatusActive, policy.Status.ValueString())
+}
+
+func TestGridILMPolicyResourceModel_Update(t *testing.T) {
+	prior := newTestGridILMPolicyResourceModel()
+	plan := newTestGridILMPolicyResourceModel()
+	plan.Activate = types.BoolValue(true)
+
+	client := &stubHttpClient{responses: []stubResponse{
+		{body: gridILMPolicyResponse(ilmPolicyStatusProposed), code: 200},
+		{code: 204},
+		{body: gridILMPolicyResponse(ilmPolicyStatusActive), code: 200},
+	}}
+
+	policy, err := plan.update(client, &prior)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{
+		"PUT /grid/ilm-policies/b71d40e2",
+		"POST /grid/ilm-policies/b71d40e2/activate",
+		"GET /grid/ilm-policies/b71d40e2",
+	}, client.requests)
+	assert.True(t, policy.Activate.ValueBool())
+
+	// an active policy is never changed, it is only read again
+	client = &stubHttpClient{responses: []stubResponse{
+		{body: gridILMPolicyResponse(ilmPolicyStatusActive), code: 200},
+	}}
+
+	_, err = plan.update(client, policy)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"GET /grid/ilm-policies/b71d40e2"}, client.requests)
+}
+
+func TestGridILMPolicyResourceModel_Validate(t *testing.T) {
+	duplicateDefault := newTestGridILMPolicyResourceModel()
+	duplicateDefault.RuleIDs = append(duplicateDefault.RuleIDs, types.StringValue("1"))
+
+	unknown := newTestGridILMPolicyResourceModel()
+	unknown.DefaultRuleID = types.StringUnknown()
+
+	tests := []struct {
+		name   string
+		model  GridILMPolicyResourceModel
+		errors []string
+	}{
+		{"valid", newTestGridILMPolicyResourceModel(), nil},
+		{"default rule in rules", duplicateDefault, []string{"Duplicate ILM policy rule"}},
+		{"unknown default rule", unknown, nil},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var summaries []string
+			for _, d := range test.model.validate() {
+				summaries = append(summaries, d.Summary())
+			}
+			assert.Equal(t, test.errors, summaries)
+		})
+	}
+}
+
+func TestGridILMPolicyResourceModel_ReadReplaced(t *testing.T) {
+	prior := newTestGridILMPolicyResourceModel()
+	prior.Activate = types.BoolValue(true)
+	prior.Status = types.StringValue(ilmPolicyStatusActive)
+
+	// activating another policy makes the policy historical, it keeps its activation
+	client := &stubHttpClient{responses: []stubResponse{
+		{body: gridILMPolicyResponse(ilmPolicyStatusHistorical), code: 200},
+	}}
+
+	policy, err := prior.read(client)
+	assert.NoError(t, err)
+	assert.True(t, policy.Activate.ValueBool())
+	assert.Equal(t, ilmPolicyStatusHistorical, policy.Status.ValueString())
+
+	// an imported policy is activated if it is the active policy
+	imported := GridILMPolicyResourceModel{ID: types.StringValue("b71d40e2")}
+	client = &stubHttpClient{responses: []stubResponse{
+		{body: gridILMPolicyResponse(ilmPolicyStatusHistorical), code: 200},
+		{body: gridILMPolicyResponse(ilmPolicyStatusActive), code: 200},
+	}}
+
+	policy, err = imported.read(client)
+	assert.NoError(t, err)
+	assert.False(t, policy.Activate.ValueBool())
+
+	policy, err = imported.read(client)
+	assert.NoError(t, err)
+	assert.True(t, policy.Activate.ValueBool())
+}
+
+func TestGridILMPolicyResourceModel_ValidateChange(t *testing.T) {
+	proposed := newTestGridILMPolicyResourceModel()
+
+	active := newTestGridILMPolicyResourceModel()
+	active.Activate = types.BoolValue(true)
+	active.Status = types.StringValue(ilmPolicyStatusActive)
+
+	historical := newTestGridILMPolicyResourceModel()
+	historical.Status = types.StringValue(ilmPolicyStatusHistorical)
+
+	replaced := newTestGridILMPolicyResourceModel()
+	replaced.Activate = types.BoolValue(true)
+	replaced.Status = types.StringValue(ilmPolicyStatusHistorical)
+
+	activated := newTestGridILMPolicyResourceModel()
+	activated.Activate = types.BoolValue(true)
+
+	renamed := newTestGridILMPolicyResourceModel()
+	renamed.Name = types.StringValue("tf-provider-acc-test-2")
+	renamed.Activate = types.BoolValue(true)
+
+	tests := []struct {
+		name        string
+		plan        GridILMPolicyResourceModel
+		prior       *GridILMPolicyResourceModel
+		diagnostics []string
+		hasError    bool
+	}{
+		{"new proposed policy", proposed, nil, nil, false},
+		{"new activated policy", activated, nil, []string{"ILM policy will be activated"}, false},
+		{"change proposed policy", renamed, &proposed, []string{"ILM policy will be activated"}, false},
+		{"unchanged active policy", active, &active, nil, false},
+		{"change active policy", renamed, &active, []string{"ILM policy cannot be changed"}, true},
+		{"deactivate active policy", proposed, &active, []string{"ILM policy stays active"}, false},
+		{"unchanged historical policy", proposed, &historical, nil, false},
+		{"activate historical policy", activated, &historical, []string{"ILM policy stays historical"}, false},
+		{"unchanged replaced policy", activated, &replaced, nil, false},
+		{"deactivate replaced policy", proposed, &replaced, nil, false},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			diags := test.plan.validateChange(test.prior)
+
+			var summaries []string
+			for _, d := range diags {
+				summaries = append(summaries, d.Summary())
+			}
+			assert.Equal(t, test.diagnostics, summaries)
+			assert.Equal(t, test.hasError, diags.HasError())
+		})
+	}
+}
@@ -0,0 +1,296 @@
+// Copyright (c) github.com/dmpe
+// SPDX-License-Identifier: MIT
+
+package provider
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+const (
+	ilmReferenceTimeIngest              = "ingest"
+	ilmReferenceTimeLastAccess          = "last-access"
+	ilmReferenceTimeNoncurrent          = "noncurrent"
+	ilmReferenceTimeUserDefinedCreation = "user-defined-creation"
+
+	ilmIngestBehaviorStrict     = "strict"
+	ilmIngestBehaviorBalanced   = "balanced"
+	ilmIngestBehaviorDualCommit = "dual-commit"
+
+	ilmCopyTypeReplicated   = "replicated"
+	ilmCopyTypeErasureCoded = "erasureCoded"
+)
+
+// ilmReferenceTimes maps the reference times of the Terraform schema to the ones of the API.
+var ilmReferenceTimes = map[string]string{
+	ilmReferenceTimeIngest:              "ingestTime",
+	ilmReferenceTimeLastAccess:          "lastAccessTime",
+	ilmReferenceTimeNoncurrent:          "noncurrentTime",
+	ilmReferenceTimeUserDefinedCreation: "userDefinedCreationTime",
+}
+
+// ilmIngestBehaviors maps the ingest behaviors of the Terraform schema to the ones of the API.
+var ilmIngestBehaviors = map[string]string{
+	ilmIngestBehaviorStrict:     "strict",
+	ilmIngestBehaviorBalanced:   "balanced",
+	ilmIngestBehaviorDualCommit: "dualCommit",
+}
+
+type GridILMRuleResourceModel struct {
+	ID             types.String            `tfsdk:"id"`
+	Name           types.String            `tfsdk:"name"`
+	Description    types.String            `tfsdk:"description"`
+	Filter         *GridILMRuleFilterModel `tfsdk:"filter"`
+	ReferenceTime  types.String            `tfsdk:"reference_time"`
+	IngestBehavior types.String            `tfsdk:"ingest_behavior"`
+	Placements     []GridILMPlacementModel `tfsdk:"placements"`
+}
+
+// GridILMRuleFilterModel selects the objects an ILM rule applies to. A rule without filter applies to all objects.
+type GridILMRuleFilterModel struct {
+	TenantIDs  []types.String `tfsdk:"tenant_ids"`
+	BucketName types.String   `tfsdk:"bucket_name"`
+	KeyPrefix  types.String   `tfsdk:"key_prefix"`
+}
+
+// GridILMPlacementModel keeps copies of an object from the start day on, either for the given number of days or
+// forever.
+type GridILMPlacementModel struct {
+	StartDay     types.Int64        `tfsdk:"start_day"`
+	DurationDays types.Int64        `tfsdk:"duration_days"`
+	Copies       []GridILMCopyModel `tfsdk:"copies"`
+}
+
+// GridILMCopyModel is either a number of replicated copies in a storage pool, or an erasure coded copy according to
+// an erasure coding profile.
+type GridILMCopyModel struct {
+	StoragePoolID          types.String `tfsdk:"storage_pool_id"`
+	ErasureCodingProfileID types.String `tfsdk:"erasure_coding_profile_id"`
+	Count                  types.Int64  `tfsdk:"count"`
+}
+
+type GridILMRuleApiModel struct {
+	ID             string                     `json:"id,omitempty"`
+	Name           string                     `json:"name"`
+	Description    string                     `json:"description,omitempty"`
+	TenantAccounts []string                   `json:"tenantAccounts,omitempty"`
+	BucketName     string                     `json:"bucketName,omitempty"`
+	KeyPrefix      string                     `json:"keyPrefix,omitempty"`
+	ReferenceTime  string                     `json:"referenceTime"`
+	IngestBehavior string                     `json:"ingestBehavior"`
+	Placements     []GridILMPlacementApiModel `json:"placements"`
+}
+
+type GridILMPlacementApiModel struct {
+	StartDay int64                 `json:"startDay"`
+	Duration *int64                `json:"duration,omitempty"`
+	Copies   []GridILMCopyApiModel `json:"copies"`
+}
+
+type GridILMCopyApiModel struct {
+	Type        string `json:"type"`
+	StoragePool string `json:"storagePool,omitempty"`
+	ProfileID   string `json:"profileId,omitempty"`
+	Count       int64  `json:"count"`
+}
+
+func (m *GridILMRuleResourceModel) toGridILMRuleApiModel() *GridILMRuleApiModel {
+	rule := &GridILMRuleApiModel{
+		ID:             m.ID.ValueString(),
+		Name:           m.Name.ValueString(),
+		Description:    m.Description.ValueString(),
+		ReferenceTime:  ilmReferenceTimes[m.ReferenceTime.ValueString()],
+		IngestBehavior: ilmIngestBehaviors[m.IngestBehavior.ValueString()],
+		Placements:     make([]GridILMPlacementApiModel, len(m.Placements)),
+	}
+
+	if m.Filter != nil {
+		rule.TenantAccounts = toJson(m.Filter.TenantIDs)
+		rule.BucketName = m.Filter.BucketName.ValueString()
+		rule.KeyPrefix = m.Filter.KeyPrefix.ValueString()
+	}
+
+	for i, placement := range m.Placements {
+		rule.Placements[i] = GridILMPlacementApiModel{
+			StartDay: placement.StartDay.ValueInt64(),
+			Duration: placement.DurationDays.ValueInt64Pointer(),
+			Copies:   make([]GridILMCopyApiModel, len(placement.Copies)),
+		}
+		for j, c := range placement.Copies {
+			apiCopy := GridILMCopyApiModel{Type: ilmCopyTypeReplicated, StoragePool: c.StoragePoolID.ValueString(), Count: c.Count.ValueInt64()}
+			if !c.ErasureCodingProfileID.IsNull() {
+				apiCopy = GridILMCopyApiModel{Type: ilmCopyTypeErasureCoded, ProfileID: c.ErasureCodingProfileID.ValueString(), Count: 1}
+			}
+			rule.Placements[i].Copies[j] = apiCopy
+		}
+	}
+
+	return rule
+}
+
+func (m *GridILMRuleResourceModel) create(client HttpClient) (*GridILMRuleResourceModel, error) {
+	respBody, _, _, err := client.SendRequest("POST", api_grid_ilm_rules, m.toGridILMRuleApiModel(), 201)
+	if err != nil {
+		return nil, fmt.Errorf("unable to create ILM rule: %w", err)
+	}
+
+	return NewGridILMRuleResourceModel(respBody)
+}
+
+func (m *GridILMRuleResourceModel) read(client HttpClient) (*GridILMRuleResourceModel, error) {
+	endpoint := fmt.Sprintf("%s/%s", api_grid_ilm_rules, m.ID.ValueString())
+	respBody, _, respCode, err := client.SendRequest("GET", endpoint, nil, 200)
+	if err != nil {
+		if respCode == http.StatusNotFound {
+			return nil, ErrGridILMRuleNotFound
+		}
+		return nil, fmt.Errorf("unable to read ILM rule: %w", err)
+	}
+
+	return NewGridILMRuleResourceModel(respBody)
+}
+
+func (m *GridILMRuleResourceModel) update(client HttpClient) (*GridILMRuleResourceModel, error) {
+	endpoint := fmt.Sprintf("%s/%s", api_grid_ilm_rules, m.ID.ValueString())
+	respBody, _, respCode, err := client.SendRequest("PUT", endpoint, m.toGridILMRuleApiModel(), 200)
+	if err != nil {
+		if respCode == http.StatusNotFound {
+			return nil, ErrGridILMRuleNotFound
+		}
+		return nil, fmt.Errorf("unable to update ILM rule: %w", err)
+	}
+
+	return NewGridILMRuleResourceModel(respBody)
+}
+
+func (m *GridILMRuleResourceModel) delete(client HttpClient) error {
+	endpoint := fmt.Sprintf("%s/%s", api_grid_ilm_rules, m.ID.ValueString())
+	_, _, respCode, err := client.SendRequest("DELETE", endpoint, nil, 204)
+	if err != nil {
+		if respCode == http.StatusNotFound {
+			return ErrGridILMRuleNotFound
+		}
+		return fmt.Errorf("unable to delete ILM rule: %w", err)
+	}
+
+	return nil
+}
+
+// NewGridILMRuleResourceModel parses the JSON response from the API into a GridILMRuleResourceModel.
+func NewGridILMRuleResourceModel(input []byte) (*GridILMRuleResourceModel, error) {
+	type responseDataType struct {
+		Data GridILMRuleApiModel `json:"data"`
+	}
+
+	var returnBody responseDataType
+	if err := json.Unmarshal(input, &returnBody); err != nil {
+		return nil, &GenericError{Summary: "Client Error", Details: "Unable to parse ILM rule response, got error: " + err.Error()}
+	}
+
+	rule := returnBody.Data
+	model := &GridILMRuleResourceModel{
+		ID:             types.StringValue(rule.ID),
+		Name:           types.StringValue(rule.Name),
+		Description:    stringOrNull(rule.Description),
+		ReferenceTime:  types.StringValue(reverseLookup(ilmReferenceTimes, rule.ReferenceTime)),
+		IngestBehavior: types.StringValue(reverseLookup(ilmIngestBehaviors, rule.IngestBehavior)),
+		Placements:     make([]GridILMPlacementModel, len(rule.Placements)),
+	}
+
+	if len(rule.TenantAccounts) > 0 || rule.BucketName != "" || rule.KeyPrefix != "" {
+		model.Filter = &GridILMRuleFilterModel{
+			TenantIDs:  toTerraformOrNull(rule.TenantAccounts),
+			BucketName: stringOrNull(rule.BucketName),
+			KeyPrefix:  stringOrNull(rule.KeyPrefix),
+		}
+	}
+
+	for i, placement := range rule.Placements {
+		model.Placements[i] = GridILMPlacementModel{
+			StartDay:     types.Int64Value(placement.StartDay),
+			DurationDays: types.Int64PointerValue(placement.Duration),
+			Copies:       make([]GridILMCopyModel, len(placement.Copies)),
+		}
+		for j, c := range placement.Copies {
+			modelCopy := GridILMCopyModel{
+				StoragePoolID:          types.StringValue(c.StoragePool),
+				ErasureCodingProfileID: types.StringNull(),
+				Count:                  types.Int64Value(c.Count),
+			}
+			if c.Type == ilmCopyTypeErasureCoded {
+				modelCopy = GridILMCopyModel{
+					StoragePoolID:          types.StringNull(),
+					ErasureCodingProfileID: types.StringValue(c.ProfileID),
+					Count:                  types.Int64Value(1),
+				}
+			}
+			model.Placements[i].Copies[j] = modelCopy
+		}
+	}
+
+	return model, nil
+}
+
+// reverseLookup returns the key of the given value, or the value itself if the mapping does not know it.
+func reverseLookup(mapping map[string]string, value string) string {
+	for k, v := range mapping {
+		if v == value {
+			return k
+		}
+	}
+	return value
+}
+
+// validate checks the filter, the ingest behavior and the copies of each placement of the rule.
+func (m *GridILMRuleResourceModel) validate() diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if f := m.Filter; f != nil && f.TenantIDs == nil && f.BucketName.IsNull() && f.KeyPrefix.IsNull() {
+		diags.AddAttributeError(path.Root("filter"), "Empty ILM rule filter",
+			"The 'filter' requires 'tenant_ids', 'bucket_name' or 'key_prefix'. Remove it to apply the rule to all objects.")
+	}
+
+	if f := m.Filter; f != nil && !f.KeyPrefix.IsNull() && f.BucketName.IsNull() {
+		diags.AddAttributeError(path.Root("filter").AtName("key_prefix"), "Missing ILM rule bucket name",
+			"A 'key_prefix' requires the 'bucket_name' of the objects.")
+	}
+
+	if !m.ReferenceTime.IsUnknown() && m.ReferenceTime.ValueString() == ilmReferenceTimeNoncurrent &&
+		!m.IngestBehavior.IsUnknown() && m.IngestBehavior.ValueString() == ilmIngestBehaviorStrict {
+		// noncurrent versions are only created after the ingest, there is no initial placement to be strict about
+		diags.AddAttributeError(path.Root("ingest_behavior"), "Invalid ILM ingest behavior",
+			"The ingest behavior 'strict' cannot be combined with the reference time 'noncurrent'.")
+	}
+
+	for i, placement := range m.Placements {
+		placementPath := path.Root("placements").AtListIndex(i)
+
+		if placement.DurationDays.IsNull() && i < len(m.Placements)-1 {
+			diags.AddAttributeError(placementPath.AtName("duration_days"), "Invalid ILM placement duration",
+				"Only the last placement may keep the copies forever.")
+		}
+
+		for j, c := range placement.Copies {
+			if c.StoragePoolID.IsUnknown() || c.ErasureCodingProfileID.IsUnknown() {
+				continue
+			}
+			copyPath := placementPath.AtName("copies").AtListIndex(j)
+			if c.StoragePoolID.IsNull() == c.ErasureCodingProfileID.IsNull() {
+				diags.AddAttributeError(copyPath, "Invalid ILM copy",
+					"Exactly one of 'storage_pool_id' or 'erasure_coding_profile_id' must be set.")
+			}
+			if !c.ErasureCodingProfileID.IsNull() && !c.Count.IsNull() && !c.Count.IsUnknown() && c.Count.ValueInt64() != 1 {
+				diags.AddAttributeError(copyPath.AtName("count"), "Invalid ILM copy count",
+					"An erasure coded copy is a single copy, the 'count' must be 1.")
+			}
+		}
+	}
+
+	return diags
+}
@@ -0,0 +1,289 @@
+// Copyright (c) github.com/dmpe
+// SPDX-License-Identifier: MIT
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider-defined types fully satisfy framework interfaces.
+var (
+	_ resource.Resource                   = &gridILMRuleResource{}
+	_ resource.ResourceWithConfigure      = &gridILMRuleResource{}
+	_ resource.ResourceWithImportState    = &gridILMRuleResource{}
+	_ resource.ResourceWithValidateConfig = &gridILMRuleResource{}
+)
+
+// NewGridILMRuleResource returns a new resource instance.
+func NewGridILMRuleResource() resource.Resource {
+	return &gridILMRuleResource{}
+}
+
+type gridILMRuleResource struct {
+	client *S3GridClient
+}
+
+func (r *gridILMRuleResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_grid_ilm_rule"
+}
+
+func (r *gridILMRuleResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `
+Manage an information lifecycle management (ILM) rule of the grid. An ILM rule decides where, how and for how long the copies of the matching objects are stored.
+
+**Note:**
+- The provider must authenticate as grid administrator, i.e. with 'grid_admin = true'.
+- A rule has no effect until it is part of the active ILM policy ('storagegrid_grid_ilm_policy').
+- StorageGRID refuses to change or delete a rule which is used by the active or proposed ILM policy.
+- Erasure coding profiles are referenced by their ID, they are not managed by this provider.
+- Import by the ID of the ILM rule.
+`,
+		Attributes: map[string]schema.Attribute{
+			id: schema.StringAttribute{
+				Computed:    true,
+				Description: "The unique identifier of the ILM rule.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Required:    true,
+				Description: "The name of the ILM rule.",
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 64),
+				},
+			},
+			"description": schema.StringAttribute{
+				Optional:    true,
+				Description: "The description of the ILM rule.",
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 128),
+				},
+			},
+			"filter": schema.SingleNestedAttribute{
+				Optional:    true,
+				Description: "The objects the rule applies to. Without filter the rule applies to all objects.",
+				Attributes: map[string]schema.Attribute{
+					"tenant_ids": schema.ListAttribute{
+						ElementType: types.StringType,
+						Optional:    true,
+						Description: "Only objects of these tenants are affected, e.g. the account IDs from 'storagegrid_grid_tenant'.",
+						Validators: []validator.List{
+							listvalidator.SizeAtLeast(1),
+							listvalidator.UniqueValues(),
+						},
+					},
+					"bucket_name": schema.StringAttribute{
+						Optional:    true,
+						Description: "Only objects of the bucket with this name are affected.",
+						Validators: []validator.String{
+							stringvalidator.LengthAtLeast(1),
+						},
+					},
+					"key_prefix": schema.StringAttribute{
+						Optional:    true,
+						Description: "Only objects whose key starts with this prefix are affected. Requires 'bucket_name'.",
+						Validators: []validator.String{
+							stringvalidator.LengthAtLeast(1),
+						},
+					},
+				},
+			},
+			"reference_time": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "The time from which the days of the placements are counted. Can be 'ingest', 'last-access', 'noncurrent' or 'user-defined-creation'. Defaults to 'ingest'.",
+				Default:     stringdefault.StaticString(ilmReferenceTimeIngest),
+				Validators: []validator.String{
+					stringvalidator.OneOf(ilmReferenceTimeIngest, ilmReferenceTimeLastAccess, ilmReferenceTimeNoncurrent, ilmReferenceTimeUserDefinedCreation),
+				},
+			},
+			"ingest_behavior": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "How the objects are protected while they are ingested. Can be 'strict', 'balanced' or 'dual-commit'. Defaults to 'balanced'.",
+				Default:     stringdefault.StaticString(ilmIngestBehaviorBalanced),
+				Validators: []validator.String{
+					stringvalidator.OneOf(ilmIngestBehaviorStrict, ilmIngestBehaviorBalanced, ilmIngestBehaviorDualCommit),
+				},
+			},
+			"placements": schema.ListNestedAttribute{
+				Required:    true,
+				Description: "The placements of the object copies, in chronological order.",
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+				},
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"start_day": schema.Int64Attribute{
+							Required:    true,
+							Description: "The day, counted from the reference time, on which the placement starts.",
+							Validators: []validator.Int64{
+								int64validator.AtLeast(0),
+							},
+						},
+						"duration_days": schema.Int64Attribute{
+							Optional:    true,
+							Description: "The number of days the copies are kept. The copies are kept forever if omitted, which is only allowed for the last placement.",
+							Validators: []validator.Int64{
+								int64validator.AtLeast(1),
+							},
+						},
+						"copies": schema.ListNestedAttribute{
+							Required:    true,
+							Description: "The copies which are kept during the placement.",
+							Validators: []validator.List{
+								listvalidator.SizeAtLeast(1),
+							},
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									"storage_pool_id": schema.StringAttribute{
+										Optional:    true,
+										Description: "The ID of the storage pool of replicated copies, e.g. from 'storagegrid_grid_storage_pool'.",
+									},
+									"erasure_coding_profile_id": schema.StringAttribute{
+										Optional:    true,
+										Description: "The ID of the erasure coding profile of an erasure coded copy.",
+									},
+									"count": schema.Int64Attribute{
+										Optional:    true,
+										Computed:    true,
+										Description: "The number of replicated copies. Must be 1 for an erasure coded copy. Defaults to 1.",
+										Default:     int64default.StaticInt64(1),
+										Validators: []validator.Int64{
+											int64validator.AtLeast(1),
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *gridILMRuleResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*S3GridClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	resp.Diagnostics.Append(requireGridScope(client)...)
+
+	r.client = client
+}
+
+func (r *gridILMRuleResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config GridILMRuleResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(config.validate()...)
+}
+
+func (r *gridILMRuleResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan GridILMRuleResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	rule, err := plan.create(r.client)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, rule)...)
+}
+
+func (r *gridILMRuleResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state GridILMRuleResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	read, err := state.read(r.client)
+	if err != nil {
+		if errors.Is(err, ErrGridILMRuleNotFound) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Error Reading StorageGrid ILM rule", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, read)...)
+}
+
+func (r *gridILMRuleResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan GridILMRuleResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	rule, err := plan.update(r.client)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, rule)...)
+}
+
+func (r *gridILMRuleResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state GridILMRuleResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := state.delete(r.client)
+	if err != nil && !errors.Is(err, ErrGridILMRuleNotFound) {
+		resp.Diagnostics.AddError("Error Deleting StorageGrid ILM rule", err.Error())
+		return
+	}
+}
+
+func (r *gridILMRuleResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root(id), req, resp)
+}
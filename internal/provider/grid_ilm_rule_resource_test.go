@@ -0,0 +1,105 @@
+// Copyright (c) github.com/dmpe
+// SPDX-License-Identifier: MIT
+
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestGridILMRuleResource(t *testing.T) {
+	gridProvider := gridProviderConfiguration(t)
+	siteID := gridSiteID(t)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"storagegrid": providerserver.NewProtocol6WithError(New("test")()),
+		},
+		Steps: []resource.TestStep{
+			// Copy with storage pool and erasure coding profile
+			{
+				Config: gridProvider + `
+resource "storagegrid_grid_ilm_rule" "test" {
+	name = "tf-provider-acc-test"
+
+	placements = [
+		{
+			start_day = 0
+			copies = [
+				{
+					storage_pool_id           = "1"
+					erasure_coding_profile_id = "1"
+				},
+			]
+		},
+	]
+}`,
+				ExpectError: regexp.MustCompile("Invalid ILM copy"),
+			},
+			// Create
+			{
+				Config: gridILMRuleConfiguration(gridProvider, siteID, "balanced"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("storagegrid_grid_ilm_rule.test", "id"),
+					resource.TestCheckResourceAttr("storagegrid_grid_ilm_rule.test", "reference_time", "ingest"),
+					resource.TestCheckResourceAttr("storagegrid_grid_ilm_rule.test", "filter.bucket_name", "tf-provider-acc-test"),
+					resource.TestCheckResourceAttr("storagegrid_grid_ilm_rule.test", "placements.0.copies.0.count", "2"),
+					resource.TestCheckNoResourceAttr("storagegrid_grid_ilm_rule.test", "placements.1.duration_days"),
+				),
+			},
+			// Update
+			{
+				Config: gridILMRuleConfiguration(gridProvider, siteID, "strict"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("storagegrid_grid_ilm_rule.test", "ingest_behavior", "strict"),
+				),
+			},
+			// Import
+			{
+				ResourceName:      "storagegrid_grid_ilm_rule.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Delete testing is done automatically
+		},
+	})
+}
+
+func gridILMRuleConfiguration(gridProvider string, siteID string, ingestBehavior string) string {
+	return gridStoragePoolConfiguration(gridProvider, siteID, "tf-provider-acc-test") + fmt.Sprintf(`
+resource "storagegrid_grid_ilm_rule" "test" {
+	name            = "tf-provider-acc-test"
+	ingest_behavior = "%s"
+
+	filter = {
+		bucket_name = "tf-provider-acc-test"
+	}
+
+	placements = [
+		{
+			start_day     = 0
+			duration_days = 30
+			copies = [
+				{
+					storage_pool_id = storagegrid_grid_storage_pool.test.id
+					count           = 2
+				},
+			]
+		},
+		{
+			start_day = 30
+			copies = [
+				{
+					storage_pool_id = storagegrid_grid_storage_pool.test.id
+				},
+			]
+		},
+	]
+}`, ingestBehavior)
+}
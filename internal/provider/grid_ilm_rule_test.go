@@ -0,0 +1,131 @@
+// Copyright (c) github.com/dmpe
+// SPDX-License-Identifier: MIT
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+)
+
+const gridILMRuleResponse = `{"data": {
+	"id": "9c3e51aa", "name": "tf-provider-acc-test", "tenantAccounts": ["27983238148563830000"], "bucketName": "logs",
+	"referenceTime": "ingestTime", "ingestBehavior": "dualCommit",
+	"placements": [
+		{"startDay": 0, "duration": 30, "copies": [{"type": "replicated", "storagePool": "4f2a09c1", "count": 2}]},
+		{"startDay": 30, "copies": [{"type": "erasureCoded", "profileId": "6", "count": 1}]}
+	]
+}}`
+
+func newTestGridILMRuleResourceModel() GridILMRuleResourceModel {
+	return GridILMRuleResourceModel{
+		ID:          types.StringUnknown(),
+		Name:        types.StringValue("tf-provider-acc-test"),
+		Description: types.StringNull(),
+		Filter: &GridILMRuleFilterModel{
+			TenantIDs:  []types.String{types.StringValue("27983238148563830000")},
+			BucketName: types.StringValue("logs"),
+			KeyPrefix:  types.StringNull(),
+		},
+		ReferenceTime:  types.StringValue(ilmReferenceTimeIngest),
+		IngestBehavior: types.StringValue(ilmIngestBehaviorDualCommit),
+		Placements: []GridILMPlacementModel{
+			{
+				StartDay:     types.Int64Value(0),
+				DurationDays: types.Int64Value(30),
+				Copies: []GridILMCopyModel{
+					{StoragePoolID: types.StringValue("4f2a09c1"), ErasureCodingProfileID: types.StringNull(), Count: types.Int64Value(2)},
+				},
+			},
+			{
+				StartDay:     types.Int64Value(30),
+				DurationDays: types.Int64Null(),
+				Copies: []GridILMCopyModel{
+					{StoragePoolID: types.StringNull(), ErasureCodingProfileID: types.StringValue("6"), Count: types.Int64Value(1)},
+				},
+			},
+		},
+	}
+}
+
+func TestGridILMRuleResourceModel_Create(t *testing.T) {
+	model := newTestGridILMRuleResourceModel()
+
+	client := &stubHttpClient{responses: []stubResponse{
+		{body: gridILMRuleResponse, code: 201},
+	}}
+
+	rule, err := model.create(client)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"POST /grid/ilm-rules"}, client.requests)
+
+	payload := client.payloads[0].(*GridILMRuleApiModel)
+	assert.Equal(t, "ingestTime", payload.ReferenceTime)
+	assert.Equal(t, "dualCommit", payload.IngestBehavior)
+	assert.Equal(t, []string{"27983238148563830000"}, payload.TenantAccounts)
+	assert.Equal(t, int64(30), *payload.Placements[0].Duration)
+	assert.Nil(t, payload.Placements[1].Duration)
+	assert.Equal(t, GridILMCopyApiModel{Type: "replicated", StoragePool: "4f2a09c1", Count: 2}, payload.Placements[0].Copies[0])
+	assert.Equal(t, GridILMCopyApiModel{Type: "erasureCoded", ProfileID: "6", Count: 1}, payload.Placements[1].Copies[0])
+
+	model.ID = types.StringValue("9c3e51aa")
+	assert.Equal(t, &model, rule)
+}
+
+func TestGridILMRuleResourceModel_WithoutFilter(t *testing.T) {
+	rule, err := NewGridILMRuleResourceModel([]byte(`{"data": {"id": "9c3e51aa", "name": "default",
+		"referenceTime": "lastAccessTime", "ingestBehavior": "balanced", "placements": []}}`))
+	assert.NoError(t, err)
+	assert.Nil(t, rule.Filter)
+	assert.Equal(t, ilmReferenceTimeLastAccess, rule.ReferenceTime.ValueString())
+}
+
+func TestGridILMRuleResourceModel_Validate(t *testing.T) {
+	emptyFilter := newTestGridILMRuleResourceModel()
+	emptyFilter.Filter = &GridILMRuleFilterModel{BucketName: types.StringNull(), KeyPrefix: types.StringNull()}
+
+	prefixWithoutBucket := newTestGridILMRuleResourceModel()
+	prefixWithoutBucket.Filter = &GridILMRuleFilterModel{BucketName: types.StringNull(), KeyPrefix: types.StringValue("logs/")}
+
+	strictNoncurrent := newTestGridILMRuleResourceModel()
+	strictNoncurrent.ReferenceTime = types.StringValue(ilmReferenceTimeNoncurrent)
+	strictNoncurrent.IngestBehavior = types.StringValue(ilmIngestBehaviorStrict)
+
+	foreverNotLast := newTestGridILMRuleResourceModel()
+	foreverNotLast.Placements[0].DurationDays = types.Int64Null()
+
+	invalidCopies := newTestGridILMRuleResourceModel()
+	invalidCopies.Placements[0].Copies[0].ErasureCodingProfileID = types.StringValue("6")
+	invalidCopies.Placements[0].Copies[0].Count = types.Int64Value(1)
+	invalidCopies.Placements[1].Copies[0].Count = types.Int64Value(2)
+
+	unknown := newTestGridILMRuleResourceModel()
+	unknown.ReferenceTime = types.StringUnknown()
+	unknown.Placements[0].Copies[0].StoragePoolID = types.StringUnknown()
+
+	tests := []struct {
+		name   string
+		model  GridILMRuleResourceModel
+		errors []string
+	}{
+		{"valid", newTestGridILMRuleResourceModel(), nil},
+		{"empty filter", emptyFilter, []string{"Empty ILM rule filter"}},
+		{"key prefix without bucket", prefixWithoutBucket, []string{"Missing ILM rule bucket name"}},
+		{"strict ingest of noncurrent versions", strictNoncurrent, []string{"Invalid ILM ingest behavior"}},
+		{"forever before last placement", foreverNotLast, []string{"Invalid ILM placement duration"}},
+		{"invalid copies", invalidCopies, []string{"Invalid ILM copy", "Invalid ILM copy count"}},
+		{"unknown values", unknown, nil},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var summaries []string
+			for _, d := range test.model.validate() {
+				summaries = append(summaries, d.Summary())
+			}
+			assert.Equal(t, test.errors, summaries)
+		})
+	}
+}
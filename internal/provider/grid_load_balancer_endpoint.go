@@ -0,0 +1,299 @@
+// Copyright (c) github.com/dmpe
+// SPDX-License-Identifier: MIT
+
+package provider
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+const (
+	loadBalancerProtocolHTTP  = "http"
+	loadBalancerProtocolHTTPS = "https"
+
+	loadBalancerBindingGlobal         = "global"
+	loadBalancerBindingHAGroups       = "ha-groups"
+	loadBalancerBindingNodeInterfaces = "node-interfaces"
+	loadBalancerBindingNodeTypes      = "node-types"
+
+	loadBalancerNodeTypeAdmin   = "admin"
+	loadBalancerNodeTypeGateway = "gateway"
+
+	loadBalancerTenantsAllowAll      = "allowAll"
+	loadBalancerTenantsAllowSelected = "allowSelected"
+	loadBalancerTenantsBlockSelected = "blockSelected"
+)
+
+// loadBalancerBindingModes maps the binding mode of the resource to the binding mode of the API.
+var loadBalancerBindingModes = map[string]string{
+	loadBalancerBindingGlobal:         "global",
+	loadBalancerBindingHAGroups:       "haGroupVIPs",
+	loadBalancerBindingNodeInterfaces: "nodeInterfaces",
+	loadBalancerBindingNodeTypes:      "nodeType",
+}
+
+type GridLoadBalancerEndpointResourceModel struct {
+	ID                types.String             `tfsdk:"id"`
+	DisplayName       types.String             `tfsdk:"display_name"`
+	Port              types.Int64              `tfsdk:"port"`
+	Protocol          types.String             `tfsdk:"protocol"`
+	ServiceType       types.String             `tfsdk:"service_type"`
+	Certificate       types.String             `tfsdk:"certificate"`
+	CaBundle          types.String             `tfsdk:"ca_bundle"`
+	PrivateKey        types.String             `tfsdk:"private_key_wo"`
+	PrivateKeyVersion types.Int64              `tfsdk:"private_key_wo_version"`
+	BindingMode       types.String             `tfsdk:"binding_mode"`
+	HAGroupIDs        []types.String           `tfsdk:"ha_group_ids"`
+	NodeInterfaces    []GridNodeInterfaceModel `tfsdk:"node_interfaces"`
+	NodeTypes         []types.String           `tfsdk:"node_types"`
+	AllowedTenantIDs  []types.String           `tfsdk:"allowed_tenant_ids"`
+	BlockedTenantIDs  []types.String           `tfsdk:"blocked_tenant_ids"`
+}
+
+type GridLoadBalancerEndpointApiModel struct {
+	ID                 string                               `json:"id,omitempty"`
+	DisplayName        string                               `json:"displayName"`
+	Port               int64                                `json:"port"`
+	Secure             bool                                 `json:"secure"`
+	DefaultServiceType string                               `json:"defaultServiceType"`
+	ServerCertificate  string                               `json:"serverCertificate,omitempty"`
+	CaBundle           string                               `json:"caBundle,omitempty"`
+	PrivateKey         string                               `json:"privateKey,omitempty"`
+	BindingMode        string                               `json:"bindingMode"`
+	PinTargets         GridLoadBalancerPinTargetsApiModel   `json:"pinTargets"`
+	TenantAccess       GridLoadBalancerTenantAccessApiModel `json:"tenantAccess"`
+}
+
+type GridLoadBalancerPinTargetsApiModel struct {
+	HAGroups       []string                    `json:"haGroups"`
+	NodeInterfaces []GridNodeInterfaceApiModel `json:"nodeInterfaces"`
+	NodeTypes      []string                    `json:"nodeTypes"`
+}
+
+type GridLoadBalancerTenantAccessApiModel struct {
+	Mode       string   `json:"mode"`
+	AccountIDs []string `json:"accountIds"`
+}
+
+func (m *GridLoadBalancerEndpointResourceModel) toGridLoadBalancerEndpointApiModel() *GridLoadBalancerEndpointApiModel {
+	endpoint := &GridLoadBalancerEndpointApiModel{
+		ID:                 m.ID.ValueString(),
+		DisplayName:        m.DisplayName.ValueString(),
+		Port:               m.Port.ValueInt64(),
+		Secure:             m.Protocol.ValueString() == loadBalancerProtocolHTTPS,
+		DefaultServiceType: m.ServiceType.ValueString(),
+		ServerCertificate:  m.Certificate.ValueString(),
+		CaBundle:           m.CaBundle.ValueString(),
+		PrivateKey:         m.PrivateKey.ValueString(),
+		BindingMode:        loadBalancerBindingModes[m.BindingMode.ValueString()],
+		PinTargets: GridLoadBalancerPinTargetsApiModel{
+			HAGroups:       toJson(m.HAGroupIDs),
+			NodeInterfaces: toGridNodeInterfaceApiModels(m.NodeInterfaces),
+			NodeTypes:      toJson(m.NodeTypes),
+		},
+		TenantAccess: GridLoadBalancerTenantAccessApiModel{
+			Mode:       loadBalancerTenantsAllowAll,
+			AccountIDs: []string{},
+		},
+	}
+
+	switch {
+	case len(m.AllowedTenantIDs) > 0:
+		endpoint.TenantAccess = GridLoadBalancerTenantAccessApiModel{Mode: loadBalancerTenantsAllowSelected, AccountIDs: toJson(m.AllowedTenantIDs)}
+	case len(m.BlockedTenantIDs) > 0:
+		endpoint.TenantAccess = GridLoadBalancerTenantAccessApiModel{Mode: loadBalancerTenantsBlockSelected, AccountIDs: toJson(m.BlockedTenantIDs)}
+	}
+
+	return endpoint
+}
+
+// withSecrets copies the write-only private key of the configuration into the plan.
+func (m *GridLoadBalancerEndpointResourceModel) withSecrets(config *GridLoadBalancerEndpointResourceModel) {
+	m.PrivateKey = config.PrivateKey
+}
+
+func (m *GridLoadBalancerEndpointResourceModel) create(client HttpClient) (*GridLoadBalancerEndpointResourceModel, error) {
+	respBody, _, _, err := client.SendRequest("POST", api_grid_lb_endpoints, m.toGridLoadBalancerEndpointApiModel(), 201)
+	if err != nil {
+		return nil, fmt.Errorf("unable to create load balancer endpoint: %w", err)
+	}
+
+	return NewGridLoadBalancerEndpointResourceModel(respBody, m)
+}
+
+func (m *GridLoadBalancerEndpointResourceModel) read(client HttpClient) (*GridLoadBalancerEndpointResourceModel, error) {
+	endpoint := fmt.Sprintf("%s/%s", api_grid_lb_endpoints, m.ID.ValueString())
+	respBody, _, respCode, err := client.SendRequest("GET", endpoint, nil, 200)
+	if err != nil {
+		if respCode == http.StatusNotFound {
+			return nil, ErrGridLoadBalancerEndpointNotFound
+		}
+		return nil, fmt.Errorf("unable to read load balancer endpoint: %w", err)
+	}
+
+	return NewGridLoadBalancerEndpointResourceModel(respBody, m)
+}
+
+func (m *GridLoadBalancerEndpointResourceModel) update(client HttpClient) (*GridLoadBalancerEndpointResourceModel, error) {
+	endpoint := fmt.Sprintf("%s/%s", api_grid_lb_endpoints, m.ID.ValueString())
+	respBody, _, respCode, err := client.SendRequest("PUT", endpoint, m.toGridLoadBalancerEndpointApiModel(), 200)
+	if err != nil {
+		if respCode == http.StatusNotFound {
+			return nil, ErrGridLoadBalancerEndpointNotFound
+		}
+		return nil, fmt.Errorf("unable to update load balancer endpoint: %w", err)
+	}
+
+	return NewGridLoadBalancerEndpointResourceModel(respBody, m)
+}
+
+func (m *GridLoadBalancerEndpointResourceModel) delete(client HttpClient) error {
+	endpoint := fmt.Sprintf("%s/%s", api_grid_lb_endpoints, m.ID.ValueString())
+	_, _, respCode, err := client.SendRequest("DELETE", endpoint, nil, 204)
+	if err != nil {
+		if respCode == http.StatusNotFound {
+			return ErrGridLoadBalancerEndpointNotFound
+		}
+		return fmt.Errorf("unable to delete load balancer endpoint: %w", err)
+	}
+
+	return nil
+}
+
+// validate checks that HTTPS has a certificate with its private key, and that the pin targets match the binding mode.
+func (m *GridLoadBalancerEndpointResourceModel) validate() diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if !m.Protocol.IsUnknown() {
+		https := m.Protocol.ValueString() == loadBalancerProtocolHTTPS
+		certificates := []struct {
+			name  string
+			value types.String
+		}{
+			{"certificate", m.Certificate},
+			{"private_key_wo", m.PrivateKey},
+		}
+
+		for _, certificate := range certificates {
+			if https && certificate.value.IsNull() {
+				diags.AddAttributeError(
+					path.Root(certificate.name),
+					"Missing load balancer certificate",
+					fmt.Sprintf("The attribute '%s' is required for the protocol 'https'.", certificate.name),
+				)
+			}
+			if !https && !certificate.value.IsNull() {
+				diags.AddAttributeError(
+					path.Root(certificate.name),
+					"Unexpected load balancer certificate",
+					fmt.Sprintf("The attribute '%s' cannot be used with the protocol '%s'.", certificate.name, m.Protocol.ValueString()),
+				)
+			}
+		}
+
+		if !https && !m.CaBundle.IsNull() {
+			diags.AddAttributeError(
+				path.Root("ca_bundle"),
+				"Unexpected load balancer certificate",
+				fmt.Sprintf("The attribute 'ca_bundle' cannot be used with the protocol '%s'.", m.Protocol.ValueString()),
+			)
+		}
+	}
+
+	if m.BindingMode.IsUnknown() {
+		return diags
+	}
+
+	bindingMode := m.BindingMode.ValueString()
+	pinTargets := []struct {
+		name        string
+		count       int
+		bindingMode string
+	}{
+		{"ha_group_ids", len(m.HAGroupIDs), loadBalancerBindingHAGroups},
+		{"node_interfaces", len(m.NodeInterfaces), loadBalancerBindingNodeInterfaces},
+		{"node_types", len(m.NodeTypes), loadBalancerBindingNodeTypes},
+	}
+
+	for _, pinTarget := range pinTargets {
+		if pinTarget.bindingMode == bindingMode && pinTarget.count == 0 {
+			diags.AddAttributeError(
+				path.Root(pinTarget.name),
+				"Missing load balancer pin target",
+				fmt.Sprintf("The attribute '%s' is required for the binding mode '%s'.", pinTarget.name, bindingMode),
+			)
+		}
+		if pinTarget.bindingMode != bindingMode && pinTarget.count > 0 {
+			diags.AddAttributeError(
+				path.Root(pinTarget.name),
+				"Unexpected load balancer pin target",
+				fmt.Sprintf("The attribute '%s' cannot be used with the binding mode '%s'.", pinTarget.name, bindingMode),
+			)
+		}
+	}
+
+	return diags
+}
+
+// NewGridLoadBalancerEndpointResourceModel parses the JSON response from the API into a
+// GridLoadBalancerEndpointResourceModel. As the API never returns the private key, its version is taken from the given
+// prior model.
+func NewGridLoadBalancerEndpointResourceModel(input []byte, prior *GridLoadBalancerEndpointResourceModel) (*GridLoadBalancerEndpointResourceModel, error) {
+	type responseDataType struct {
+		Data GridLoadBalancerEndpointApiModel `json:"data"`
+	}
+
+	var returnBody responseDataType
+	if err := json.Unmarshal(input, &returnBody); err != nil {
+		return nil, &GenericError{Summary: "Client Error", Details: "Unable to parse load balancer endpoint response, got error: " + err.Error()}
+	}
+
+	endpoint := returnBody.Data
+	model := &GridLoadBalancerEndpointResourceModel{
+		ID:                types.StringValue(endpoint.ID),
+		DisplayName:       types.StringValue(endpoint.DisplayName),
+		Port:              types.Int64Value(endpoint.Port),
+		Protocol:          types.StringValue(loadBalancerProtocolHTTP),
+		ServiceType:       types.StringValue(endpoint.DefaultServiceType),
+		Certificate:       stringOrNull(endpoint.ServerCertificate),
+		CaBundle:          stringOrNull(endpoint.CaBundle),
+		PrivateKey:        types.StringNull(),
+		PrivateKeyVersion: types.Int64Null(),
+		BindingMode:       types.StringValue(loadBalancerBindingGlobal),
+		HAGroupIDs:        toTerraformOrNull(endpoint.PinTargets.HAGroups),
+		NodeTypes:         toTerraformOrNull(endpoint.PinTargets.NodeTypes),
+	}
+
+	if endpoint.Secure {
+		model.Protocol = types.StringValue(loadBalancerProtocolHTTPS)
+	}
+
+	for bindingMode, apiBindingMode := range loadBalancerBindingModes {
+		if apiBindingMode == endpoint.BindingMode {
+			model.BindingMode = types.StringValue(bindingMode)
+		}
+	}
+
+	if len(endpoint.PinTargets.NodeInterfaces) > 0 {
+		model.NodeInterfaces = toGridNodeInterfaceModels(endpoint.PinTargets.NodeInterfaces)
+	}
+
+	switch endpoint.TenantAccess.Mode {
+	case loadBalancerTenantsAllowSelected:
+		model.AllowedTenantIDs = toTerraformOrNull(endpoint.TenantAccess.AccountIDs)
+	case loadBalancerTenantsBlockSelected:
+		model.BlockedTenantIDs = toTerraformOrNull(endpoint.TenantAccess.AccountIDs)
+	}
+
+	if prior != nil {
+		model.PrivateKeyVersion = prior.PrivateKeyVersion
+	}
+
+	return model, nil
+}
@@ -0,0 +1,299 @@
+// Copyright (c) github.com/dmpe
+// SPDX-License-Identifier: MIT
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider-defined types fully satisfy framework interfaces.
+var (
+	_ resource.Resource                   = &gridLoadBalancerEndpointResource{}
+	_ resource.ResourceWithConfigure      = &gridLoadBalancerEndpointResource{}
+	_ resource.ResourceWithImportState    = &gridLoadBalancerEndpointResource{}
+	_ resource.ResourceWithValidateConfig = &gridLoadBalancerEndpointResource{}
+)
+
+// NewGridLoadBalancerEndpointResource returns a new resource instance.
+func NewGridLoadBalancerEndpointResource() resource.Resource {
+	return &gridLoadBalancerEndpointResource{}
+}
+
+type gridLoadBalancerEndpointResource struct {
+	client *S3GridClient
+}
+
+func (r *gridLoadBalancerEndpointResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_grid_load_balancer_endpoint"
+}
+
+func (r *gridLoadBalancerEndpointResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `
+Manage a load balancer endpoint of the grid, i.e. the port on which the gateway and admin nodes accept S3 or Swift requests of the clients.
+
+**Note:**
+- The provider must authenticate as grid administrator, i.e. with 'grid_admin = true'.
+- The private key of the certificate is write-only (Terraform 1.11 or later is required) and never stored in the state. Increase 'private_key_wo_version' to send a changed private key to StorageGRID.
+- The binding mode restricts the endpoint to HA groups ('storagegrid_grid_ha_group'), node interfaces or node types. The endpoint is available on all nodes with the binding mode 'global'.
+- Either the allowed or the blocked tenants may be set. All tenants may use the endpoint if neither is set.
+- As the API never returns the private key, an imported endpoint is updated once with the configured private key.
+`,
+		Attributes: map[string]schema.Attribute{
+			id: schema.StringAttribute{
+				Computed:    true,
+				Description: "The unique identifier of the load balancer endpoint.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"display_name": schema.StringAttribute{
+				Required:    true,
+				Description: "The display name of the load balancer endpoint.",
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 32),
+				},
+			},
+			"port": schema.Int64Attribute{
+				Required:    true,
+				Description: "The port of the load balancer endpoint, e.g. '10443'.",
+				Validators: []validator.Int64{
+					int64validator.Between(1, 65535),
+				},
+			},
+			"protocol": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "The protocol of the load balancer endpoint. Can be 'https' or 'http'. Defaults to 'https'.",
+				Default:     stringdefault.StaticString(loadBalancerProtocolHTTPS),
+				Validators: []validator.String{
+					stringvalidator.OneOf(loadBalancerProtocolHTTPS, loadBalancerProtocolHTTP),
+				},
+			},
+			"service_type": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "The client type of the load balancer endpoint. Can be 's3' or 'swift'. Defaults to 's3'.",
+				Default:     stringdefault.StaticString("s3"),
+				Validators: []validator.String{
+					stringvalidator.OneOf("s3", "swift"),
+				},
+			},
+			"certificate": schema.StringAttribute{
+				Optional:    true,
+				Description: "The server certificate (in PEM encoding) of the load balancer endpoint. Required for the protocol 'https'.",
+			},
+			"ca_bundle": schema.StringAttribute{
+				Optional:    true,
+				Description: "The intermediate CA certificates (in PEM encoding) of the server certificate.",
+			},
+			"private_key_wo": schema.StringAttribute{
+				Optional:    true,
+				Sensitive:   true,
+				WriteOnly:   true,
+				Description: "The private key (in PEM encoding) of the server certificate. Required for the protocol 'https'.",
+			},
+			"private_key_wo_version": schema.Int64Attribute{
+				Optional:    true,
+				Description: "An arbitrary version of the write-only private key. Change it to send a changed private key to StorageGRID.",
+			},
+			"binding_mode": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Where the load balancer endpoint is available. Can be 'global', 'ha-groups', 'node-interfaces' or 'node-types'. Defaults to 'global'.",
+				Default:     stringdefault.StaticString(loadBalancerBindingGlobal),
+				Validators: []validator.String{
+					stringvalidator.OneOf(loadBalancerBindingGlobal, loadBalancerBindingHAGroups, loadBalancerBindingNodeInterfaces, loadBalancerBindingNodeTypes),
+				},
+			},
+			"ha_group_ids": schema.ListAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+				Description: "The IDs of the HA groups on whose virtual IP addresses the endpoint is available. Required for the binding mode 'ha-groups'.",
+				Validators: []validator.List{
+					listvalidator.UniqueValues(),
+				},
+			},
+			"node_interfaces": schema.ListNestedAttribute{
+				Optional:    true,
+				Description: "The network interfaces of the nodes on which the endpoint is available. Required for the binding mode 'node-interfaces'.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"node_id": schema.StringAttribute{
+							Required:    true,
+							Description: "The ID of the gateway or admin node.",
+						},
+						"interface": schema.StringAttribute{
+							Required:    true,
+							Description: "The name of the network interface of the node, e.g. 'eth0'.",
+						},
+					},
+				},
+			},
+			"node_types": schema.ListAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+				Description: "The types of the nodes on which the endpoint is available. Can be 'admin' and 'gateway'. Required for the binding mode 'node-types'.",
+				Validators: []validator.List{
+					listvalidator.UniqueValues(),
+					listvalidator.ValueStringsAre(stringvalidator.OneOf(loadBalancerNodeTypeAdmin, loadBalancerNodeTypeGateway)),
+				},
+			},
+			"allowed_tenant_ids": schema.ListAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+				Description: "The account IDs of the only tenants which may use the endpoint, e.g. from 'storagegrid_grid_tenant'.",
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+					listvalidator.UniqueValues(),
+					listvalidator.ConflictsWith(path.MatchRoot("blocked_tenant_ids")),
+				},
+			},
+			"blocked_tenant_ids": schema.ListAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+				Description: "The account IDs of the tenants which must not use the endpoint, e.g. from 'storagegrid_grid_tenant'.",
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+					listvalidator.UniqueValues(),
+				},
+			},
+		},
+	}
+}
+
+func (r *gridLoadBalancerEndpointResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*S3GridClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	resp.Diagnostics.Append(requireGridScope(client)...)
+
+	r.client = client
+}
+
+func (r *gridLoadBalancerEndpointResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config GridLoadBalancerEndpointResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// the defaults are not applied to the configuration
+	if config.Protocol.IsNull() {
+		config.Protocol = types.StringValue(loadBalancerProtocolHTTPS)
+	}
+	if config.BindingMode.IsNull() {
+		config.BindingMode = types.StringValue(loadBalancerBindingGlobal)
+	}
+
+	resp.Diagnostics.Append(config.validate()...)
+}
+
+func (r *gridLoadBalancerEndpointResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan, config GridLoadBalancerEndpointResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan.withSecrets(&config)
+
+	endpoint, err := plan.create(r.client)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, endpoint)...)
+}
+
+func (r *gridLoadBalancerEndpointResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state GridLoadBalancerEndpointResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	read, err := state.read(r.client)
+	if err != nil {
+		if errors.Is(err, ErrGridLoadBalancerEndpointNotFound) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Error Reading StorageGrid load balancer endpoint", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, read)...)
+}
+
+func (r *gridLoadBalancerEndpointResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, config GridLoadBalancerEndpointResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan.withSecrets(&config)
+
+	endpoint, err := plan.update(r.client)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, endpoint)...)
+}
+
+func (r *gridLoadBalancerEndpointResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state GridLoadBalancerEndpointResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := state.delete(r.client)
+	if err != nil && !errors.Is(err, ErrGridLoadBalancerEndpointNotFound) {
+		resp.Diagnostics.AddError("Error Deleting StorageGrid load balancer endpoint", err.Error())
+		return
+	}
+}
+
+func (r *gridLoadBalancerEndpointResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root(id), req, resp)
+}
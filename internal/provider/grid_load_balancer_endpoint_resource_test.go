@@ -0,0 +1,72 @@
+// Copyright (c) github.com/dmpe
+// SPDX-License-Identifier: MIT
+
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestGridLoadBalancerEndpointResource(t *testing.T) {
+	gridProvider := gridProviderConfiguration(t)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"storagegrid": providerserver.NewProtocol6WithError(New("test")()),
+		},
+		Steps: []resource.TestStep{
+			// Pin target without matching binding mode
+			{
+				Config: gridProvider + `
+resource "storagegrid_grid_load_balancer_endpoint" "test" {
+	display_name = "tf-provider-acc-test"
+	port         = 18099
+	protocol     = "http"
+	node_types   = ["gateway"]
+}`,
+				ExpectError: regexp.MustCompile("Unexpected load balancer pin target"),
+			},
+			// Create
+			{
+				Config: gridLoadBalancerEndpointConfiguration(gridProvider, "tf-provider-acc-test"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("storagegrid_grid_load_balancer_endpoint.test", "id"),
+					resource.TestCheckResourceAttr("storagegrid_grid_load_balancer_endpoint.test", "port", "18099"),
+					resource.TestCheckResourceAttr("storagegrid_grid_load_balancer_endpoint.test", "binding_mode", "node-types"),
+					resource.TestCheckResourceAttr("storagegrid_grid_load_balancer_endpoint.test", "node_types.0", "gateway"),
+				),
+			},
+			// Update
+			{
+				Config: gridLoadBalancerEndpointConfiguration(gridProvider, "tf-provider-acc-test-2"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("storagegrid_grid_load_balancer_endpoint.test", "display_name", "tf-provider-acc-test-2"),
+				),
+			},
+			// Import
+			{
+				ResourceName:      "storagegrid_grid_load_balancer_endpoint.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Delete testing is done automatically
+		},
+	})
+}
+
+func gridLoadBalancerEndpointConfiguration(gridProvider string, displayName string) string {
+	return gridProvider + fmt.Sprintf(`
+resource "storagegrid_grid_load_balancer_endpoint" "test" {
+	display_name = "%s"
+	port         = 18099
+	protocol     = "http"
+	binding_mode = "node-types"
+	node_types   = ["gateway"]
+}`, displayName)
+}
@@ -0,0 +1,141 @@
+// Copyright (c) github.com/dmpe
+// SPDX-License-Identifier: MIT
+
+package provider
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+const (
+	serverCertificateManagement = "management"
+	serverCertificateS3Swift    = "s3-swift"
+)
+
+// gridServerCertificateEndpoints maps the interfaces of the resource to the server configuration of the API.
+var gridServerCertificateEndpoints = map[string]string{
+	serverCertificateManagement: api_grid_server_config + "/management-interface-certificate",
+	serverCertificateS3Swift:    api_grid_server_config + "/object-storage-api-service-endpoints-server-certificate",
+}
+
+type GridServerCertificateResourceModel struct {
+	ID                types.String `tfsdk:"id"`
+	Interface         types.String `tfsdk:"interface"`
+	Certificate       types.String `tfsdk:"certificate"`
+	CaBundle          types.String `tfsdk:"ca_bundle"`
+	PrivateKey        types.String `tfsdk:"private_key_wo"`
+	PrivateKeyVersion types.Int64  `tfsdk:"private_key_wo_version"`
+	ExpiryWarningDays types.Int64  `tfsdk:"expiry_warning_days"`
+	Fingerprint       types.String `tfsdk:"fingerprint"`
+	ExpiresAt         types.String `tfsdk:"expires_at"`
+}
+
+type GridServerCertificateApiModel struct {
+	ServerCertificateEncoded string `json:"serverCertificateEncoded"`
+	CaBundleEncoded          string `json:"caBundleEncoded,omitempty"`
+	PrivateKeyEncoded        string `json:"privateKeyEncoded,omitempty"`
+}
+
+func (m *GridServerCertificateResourceModel) toGridServerCertificateApiModel() *GridServerCertificateApiModel {
+	return &GridServerCertificateApiModel{
+		ServerCertificateEncoded: m.Certificate.ValueString(),
+		CaBundleEncoded:          m.CaBundle.ValueString(),
+		PrivateKeyEncoded:        m.PrivateKey.ValueString(),
+	}
+}
+
+// withSecrets copies the write-only private key of the configuration into the plan.
+func (m *GridServerCertificateResourceModel) withSecrets(config *GridServerCertificateResourceModel) {
+	m.PrivateKey = config.PrivateKey
+}
+
+func (m *GridServerCertificateResourceModel) endpoint() string {
+	return gridServerCertificateEndpoints[m.Interface.ValueString()]
+}
+
+// create and update both replace the certificate of the interface, as each interface always has a certificate.
+func (m *GridServerCertificateResourceModel) create(client HttpClient) (*GridServerCertificateResourceModel, error) {
+	return m.update(client)
+}
+
+// read fails with ErrGridServerCertificateNotFound if the interface uses the default certificate of StorageGRID.
+func (m *GridServerCertificateResourceModel) read(client HttpClient) (*GridServerCertificateResourceModel, error) {
+	respBody, _, respCode, err := client.SendRequest("GET", m.endpoint(), nil, 200)
+	if err != nil {
+		if respCode == http.StatusNotFound {
+			return nil, ErrGridServerCertificateNotFound
+		}
+		return nil, fmt.Errorf("unable to read server certificate: %w", err)
+	}
+
+	return NewGridServerCertificateResourceModel(respBody, m)
+}
+
+func (m *GridServerCertificateResourceModel) update(client HttpClient) (*GridServerCertificateResourceModel, error) {
+	respBody, _, _, err := client.SendRequest("PUT", m.endpoint(), m.toGridServerCertificateApiModel(), 200)
+	if err != nil {
+		return nil, fmt.Errorf("unable to update server certificate: %w", err)
+	}
+
+	return NewGridServerCertificateResourceModel(respBody, m)
+}
+
+// delete restores the default certificate of StorageGRID.
+func (m *GridServerCertificateResourceModel) delete(client HttpClient) error {
+	_, _, respCode, err := client.SendRequest("DELETE", m.endpoint(), nil, 204)
+	if err != nil {
+		if respCode == http.StatusNotFound {
+			return ErrGridServerCertificateNotFound
+		}
+		return fmt.Errorf("unable to restore default server certificate: %w", err)
+	}
+
+	return nil
+}
+
+// validate checks that the certificate is PEM encoded and that the private key belongs to it.
+func (m *GridServerCertificateResourceModel) validate() diag.Diagnostics {
+	return validateCertificate(path.Root("certificate"), m.Certificate, path.Root("private_key_wo"), m.PrivateKey)
+}
+
+// NewGridServerCertificateResourceModel parses the JSON response from the API into a
+// GridServerCertificateResourceModel. As the API never returns the private key, its version and the interface are
+// taken from the given prior model.
+func NewGridServerCertificateResourceModel(input []byte, prior *GridServerCertificateResourceModel) (*GridServerCertificateResourceModel, error) {
+	type responseDataType struct {
+		Data GridServerCertificateApiModel `json:"data"`
+	}
+
+	var returnBody responseDataType
+	if err := json.Unmarshal(input, &returnBody); err != nil {
+		return nil, &GenericError{Summary: "Client Error", Details: "Unable to parse server certificate response, got error: " + err.Error()}
+	}
+
+	certificate := returnBody.Data
+	if certificate.ServerCertificateEncoded == "" {
+		return nil, ErrGridServerCertificateNotFound
+	}
+
+	model := &GridServerCertificateResourceModel{
+		ID:                prior.Interface,
+		Interface:         prior.Interface,
+		Certificate:       reconcileCertificate(prior.Certificate, certificate.ServerCertificateEncoded),
+		CaBundle:          reconcileCertificate(prior.CaBundle, certificate.CaBundleEncoded),
+		PrivateKey:        types.StringNull(),
+		PrivateKeyVersion: prior.PrivateKeyVersion,
+		ExpiryWarningDays: prior.ExpiryWarningDays,
+	}
+	model.Fingerprint, model.ExpiresAt = certificateAttributes(certificate.ServerCertificateEncoded)
+
+	if model.ExpiryWarningDays.IsNull() {
+		model.ExpiryWarningDays = types.Int64Value(certificateExpiryWarningDays)
+	}
+
+	return model, nil
+}
@@ -0,0 +1,250 @@
+// Copyright (c) github.com/dmpe
+// SPDX-License-Identifier: MIT
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+// Ensure provider-defined types fully satisfy framework interfaces.
+var (
+	_ resource.Resource                   = &gridServerCertificateResource{}
+	_ resource.ResourceWithConfigure      = &gridServerCertificateResource{}
+	_ resource.ResourceWithImportState    = &gridServerCertificateResource{}
+	_ resource.ResourceWithModifyPlan     = &gridServerCertificateResource{}
+	_ resource.ResourceWithValidateConfig = &gridServerCertificateResource{}
+)
+
+// NewGridServerCertificateResource returns a new resource instance.
+func NewGridServerCertificateResource() resource.Resource {
+	return &gridServerCertificateResource{}
+}
+
+type gridServerCertificateResource struct {
+	client *S3GridClient
+}
+
+func (r *gridServerCertificateResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_grid_server_certificate"
+}
+
+func (r *gridServerCertificateResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `
+Manage the global server certificate of the management interface or of the S3 and Swift API of the grid. Use 'storagegrid_grid_load_balancer_endpoint' for the certificates of load balancer endpoints.
+
+**Note:**
+- The provider must authenticate as grid administrator, i.e. with 'grid_admin = true'.
+- The private key of the certificate is write-only (Terraform 1.11 or later is required) and never stored in the state. Increase 'private_key_wo_version' to send a changed private key to StorageGRID, e.g. together with a renewed certificate.
+- Every plan shows a warning once the certificate expires within 'expiry_warning_days'.
+- Destroying the resource restores the default certificate of StorageGRID.
+- Import by the interface, i.e. 'management' or 's3-swift'. As the API never returns the private key, an imported certificate is updated once with the configured private key.
+`,
+		Attributes: map[string]schema.Attribute{
+			id: schema.StringAttribute{
+				Computed:    true,
+				Description: "The interface of the certificate.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"interface": schema.StringAttribute{
+				Required:    true,
+				Description: "The interface which uses the certificate. Can be 'management' (Grid Manager and Tenant Manager) or 's3-swift' (S3 and Swift API of the storage nodes).",
+				Validators: []validator.String{
+					stringvalidator.OneOf(serverCertificateManagement, serverCertificateS3Swift),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"certificate": schema.StringAttribute{
+				Required:    true,
+				Description: "The server certificate (in PEM encoding).",
+			},
+			"ca_bundle": schema.StringAttribute{
+				Optional:    true,
+				Description: "The intermediate CA certificates (in PEM encoding) of the server certificate.",
+			},
+			"private_key_wo": schema.StringAttribute{
+				Required:    true,
+				Sensitive:   true,
+				WriteOnly:   true,
+				Description: "The private key (in PEM encoding) of the server certificate.",
+			},
+			"private_key_wo_version": schema.Int64Attribute{
+				Optional:    true,
+				Description: "An arbitrary version of the write-only private key. Change it to send a changed private key to StorageGRID.",
+			},
+			"expiry_warning_days": schema.Int64Attribute{
+				Optional:    true,
+				Computed:    true,
+				Description: fmt.Sprintf("The number of days before the expiry of the certificate, from which on every plan shows a warning. Defaults to '%d'.", certificateExpiryWarningDays),
+				Default:     int64default.StaticInt64(certificateExpiryWarningDays),
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+			"fingerprint": schema.StringAttribute{
+				Computed:    true,
+				Description: "The SHA-256 fingerprint of the certificate.",
+			},
+			"expires_at": schema.StringAttribute{
+				Computed:    true,
+				Description: "The expiry of the certificate in RFC 3339 format.",
+			},
+		},
+	}
+}
+
+func (r *gridServerCertificateResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*S3GridClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	resp.Diagnostics.Append(requireGridScope(client)...)
+
+	r.client = client
+}
+
+func (r *gridServerCertificateResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config GridServerCertificateResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(config.validate()...)
+}
+
+// ModifyPlan plans the fingerprint and the expiry of the configured certificate, and warns if it expires soon.
+func (r *gridServerCertificateResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// check if the resource is planned for destruction
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan GridServerCertificateResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(planCertificate(path.Root("certificate"), plan.Certificate, plan.ExpiryWarningDays, &plan.Fingerprint, &plan.ExpiresAt)...)
+	resp.Diagnostics.Append(resp.Plan.Set(ctx, plan)...)
+}
+
+func (r *gridServerCertificateResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan, config GridServerCertificateResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan.withSecrets(&config)
+
+	certificate, err := plan.create(r.client)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, certificate)...)
+}
+
+func (r *gridServerCertificateResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state GridServerCertificateResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	read, err := state.read(r.client)
+	if err != nil {
+		if errors.Is(err, ErrGridServerCertificateNotFound) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Error Reading StorageGrid server certificate", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, read)...)
+}
+
+func (r *gridServerCertificateResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, config GridServerCertificateResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan.withSecrets(&config)
+
+	certificate, err := plan.update(r.client)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, certificate)...)
+}
+
+func (r *gridServerCertificateResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state GridServerCertificateResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := state.delete(r.client)
+	if err != nil && !errors.Is(err, ErrGridServerCertificateNotFound) {
+		resp.Diagnostics.AddError("Error Deleting StorageGrid server certificate", err.Error())
+		return
+	}
+}
+
+// ImportState imports the certificate of an interface, the ID is the interface.
+func (r *gridServerCertificateResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	if _, ok := gridServerCertificateEndpoints[req.ID]; !ok {
+		resp.Diagnostics.AddError("Error Importing StorageGrid server certificate",
+			fmt.Sprintf("The interface must be '%s' or '%s', got '%s'.", serverCertificateManagement, serverCertificateS3Swift, req.ID))
+		return
+	}
+
+	resource.ImportStatePassthroughID(ctx, path.Root(id), req, resp)
+	resource.ImportStatePassthroughID(ctx, path.Root("interface"), req, resp)
+}
@@ -0,0 +1,71 @@
+// Copyright (c) github.com/dmpe
+// SPDX-License-Identifier: MIT
+
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestGridServerCertificateResource(t *testing.T) {
+	gridProvider := gridProviderConfiguration(t)
+	certificate, privateKey := newTestCertificate(t, time.Now().AddDate(1, 0, 0))
+	renewedCertificate, renewedPrivateKey := newTestCertificate(t, time.Now().AddDate(2, 0, 0))
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"storagegrid": providerserver.NewProtocol6WithError(New("test")()),
+		},
+		Steps: []resource.TestStep{
+			// Private key of another certificate
+			{
+				Config:      gridServerCertificateConfiguration(gridProvider, certificate, renewedPrivateKey, 1),
+				ExpectError: regexp.MustCompile("Invalid private key"),
+			},
+			// Create
+			{
+				Config: gridServerCertificateConfiguration(gridProvider, certificate, privateKey, 1),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("storagegrid_grid_server_certificate.test", "id", "s3-swift"),
+					resource.TestCheckResourceAttr("storagegrid_grid_server_certificate.test", "expiry_warning_days", "30"),
+					resource.TestCheckResourceAttrSet("storagegrid_grid_server_certificate.test", "fingerprint"),
+					resource.TestCheckResourceAttrSet("storagegrid_grid_server_certificate.test", "expires_at"),
+					resource.TestCheckNoResourceAttr("storagegrid_grid_server_certificate.test", "private_key_wo"),
+				),
+			},
+			// Update
+			{
+				Config: gridServerCertificateConfiguration(gridProvider, renewedCertificate, renewedPrivateKey, 2),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("storagegrid_grid_server_certificate.test", "private_key_wo_version", "2"),
+				),
+			},
+			// Import
+			{
+				ResourceName:      "storagegrid_grid_server_certificate.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+				// the API never returns the private key
+				ImportStateVerifyIgnore: []string{"private_key_wo_version"},
+			},
+			// Delete testing is done automatically
+		},
+	})
+}
+
+func gridServerCertificateConfiguration(gridProvider string, certificate string, privateKey string, version int) string {
+	return gridProvider + fmt.Sprintf(`
+resource "storagegrid_grid_server_certificate" "test" {
+	interface              = "s3-swift"
+	certificate            = %q
+	private_key_wo         = %q
+	private_key_wo_version = %d
+}`, certificate, privateKey, version)
+}
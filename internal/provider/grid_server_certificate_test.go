@@ -0,0 +1,70 @@
+// Copyright (c) github.com/dmpe
+// SPDX-License-Identifier: MIT
+
+package provider
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func gridServerCertificateResponse(certificate string) string {
+	encoded, _ := json.Marshal(certificate)
+	return fmt.Sprintf(`{"data": {"serverCertificateEncoded": %s, "caBundleEncoded": ""}}`, encoded)
+}
+
+func TestGridServerCertificateResourceModel_Create(t *testing.T) {
+	certificate, privateKey := newTestCertificate(t, time.Date(2030, 1, 2, 3, 4, 5, 0, time.UTC))
+	model := GridServerCertificateResourceModel{
+		ID:                types.StringUnknown(),
+		Interface:         types.StringValue(serverCertificateS3Swift),
+		Certificate:       types.StringValue(certificate),
+		CaBundle:          types.StringNull(),
+		PrivateKey:        types.StringValue(privateKey),
+		PrivateKeyVersion: types.Int64Value(1),
+		ExpiryWarningDays: types.Int64Value(60),
+		Fingerprint:       types.StringUnknown(),
+		ExpiresAt:         types.StringUnknown(),
+	}
+
+	client := &stubHttpClient{responses: []stubResponse{
+		{body: gridServerCertificateResponse(certificate), code: 200},
+	}}
+
+	created, err := model.create(client)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"PUT /grid/server-config/object-storage-api-service-endpoints-server-certificate"}, client.requests)
+	assert.Equal(t, &GridServerCertificateApiModel{ServerCertificateEncoded: certificate, PrivateKeyEncoded: privateKey}, client.payloads[0])
+
+	// the private key is never stored in the state
+	assert.True(t, created.PrivateKey.IsNull())
+	assert.Equal(t, int64(1), created.PrivateKeyVersion.ValueInt64())
+	assert.Equal(t, int64(60), created.ExpiryWarningDays.ValueInt64())
+	assert.Equal(t, serverCertificateS3Swift, created.ID.ValueString())
+	assert.Equal(t, "2030-01-02T03:04:05Z", created.ExpiresAt.ValueString())
+	assert.False(t, created.Fingerprint.IsNull())
+}
+
+func TestGridServerCertificateResourceModel_DefaultCertificate(t *testing.T) {
+	model := GridServerCertificateResourceModel{Interface: types.StringValue(serverCertificateManagement)}
+
+	client := &stubHttpClient{responses: []stubResponse{
+		{body: `{"data": {"serverCertificateEncoded": ""}}`, code: 200},
+		{code: 204},
+	}}
+
+	// StorageGRID uses its default certificate
+	_, err := model.read(client)
+	assert.ErrorIs(t, err, ErrGridServerCertificateNotFound)
+
+	assert.NoError(t, model.delete(client))
+	assert.Equal(t, []string{
+		"GET /grid/server-config/management-interface-certificate",
+		"DELETE /grid/server-config/management-interface-certificate",
+	}, client.requests)
+}
@@ -0,0 +1,129 @@
+// Copyright (c) github.com/dmpe
+// SPDX-License-Identifier: MIT
+
+package provider
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type GridStoragePoolResourceModel struct {
+	ID       types.String                    `tfsdk:"id"`
+	Name     types.String                    `tfsdk:"name"`
+	Criteria []GridStoragePoolCriterionModel `tfsdk:"criteria"`
+}
+
+// GridStoragePoolCriterionModel selects the storage nodes of a storage pool. A missing site or storage grade selects
+// all sites or storage grades.
+type GridStoragePoolCriterionModel struct {
+	SiteID         types.String `tfsdk:"site_id"`
+	StorageGradeID types.String `tfsdk:"storage_grade_id"`
+}
+
+type GridStoragePoolApiModel struct {
+	ID          string                             `json:"id,omitempty"`
+	DisplayName string                             `json:"displayName"`
+	Criteria    []GridStoragePoolCriterionApiModel `json:"criteria"`
+}
+
+type GridStoragePoolCriterionApiModel struct {
+	SiteID         string `json:"siteId,omitempty"`
+	StorageGradeID string `json:"storageGradeId,omitempty"`
+}
+
+func (m *GridStoragePoolResourceModel) toGridStoragePoolApiModel() *GridStoragePoolApiModel {
+	pool := &GridStoragePoolApiModel{
+		ID:          m.ID.ValueString(),
+		DisplayName: m.Name.ValueString(),
+		Criteria:    make([]GridStoragePoolCriterionApiModel, len(m.Criteria)),
+	}
+
+	for i, criterion := range m.Criteria {
+		pool.Criteria[i] = GridStoragePoolCriterionApiModel{
+			SiteID:         criterion.SiteID.ValueString(),
+			StorageGradeID: criterion.StorageGradeID.ValueString(),
+		}
+	}
+
+	return pool
+}
+
+func (m *GridStoragePoolResourceModel) create(client HttpClient) (*GridStoragePoolResourceModel, error) {
+	respBody, _, _, err := client.SendRequest("POST", api_grid_storage_pools, m.toGridStoragePoolApiModel(), 201)
+	if err != nil {
+		return nil, fmt.Errorf("unable to create storage pool: %w", err)
+	}
+
+	return NewGridStoragePoolResourceModel(respBody)
+}
+
+func (m *GridStoragePoolResourceModel) read(client HttpClient) (*GridStoragePoolResourceModel, error) {
+	endpoint := fmt.Sprintf("%s/%s", api_grid_storage_pools, m.ID.ValueString())
+	respBody, _, respCode, err := client.SendRequest("GET", endpoint, nil, 200)
+	if err != nil {
+		if respCode == http.StatusNotFound {
+			return nil, ErrGridStoragePoolNotFound
+		}
+		return nil, fmt.Errorf("unable to read storage pool: %w", err)
+	}
+
+	return NewGridStoragePoolResourceModel(respBody)
+}
+
+func (m *GridStoragePoolResourceModel) update(client HttpClient) (*GridStoragePoolResourceModel, error) {
+	endpoint := fmt.Sprintf("%s/%s", api_grid_storage_pools, m.ID.ValueString())
+	respBody, _, respCode, err := client.SendRequest("PUT", endpoint, m.toGridStoragePoolApiModel(), 200)
+	if err != nil {
+		if respCode == http.StatusNotFound {
+			return nil, ErrGridStoragePoolNotFound
+		}
+		return nil, fmt.Errorf("unable to update storage pool: %w", err)
+	}
+
+	return NewGridStoragePoolResourceModel(respBody)
+}
+
+func (m *GridStoragePoolResourceModel) delete(client HttpClient) error {
+	endpoint := fmt.Sprintf("%s/%s", api_grid_storage_pools, m.ID.ValueString())
+	_, _, respCode, err := client.SendRequest("DELETE", endpoint, nil, 204)
+	if err != nil {
+		if respCode == http.StatusNotFound {
+			return ErrGridStoragePoolNotFound
+		}
+		return fmt.Errorf("unable to delete storage pool: %w", err)
+	}
+
+	return nil
+}
+
+// NewGridStoragePoolResourceModel parses the JSON response from the API into a GridStoragePoolResourceModel.
+func NewGridStoragePoolResourceModel(input []byte) (*GridStoragePoolResourceModel, error) {
+	type responseDataType struct {
+		Data GridStoragePoolApiModel `json:"data"`
+	}
+
+	var returnBody responseDataType
+	if err := json.Unmarshal(input, &returnBody); err != nil {
+		return nil, &GenericError{Summary: "Client Error", Details: "Unable to parse storage pool response, got error: " + err.Error()}
+	}
+
+	pool := returnBody.Data
+	model := &GridStoragePoolResourceModel{
+		ID:       types.StringValue(pool.ID),
+		Name:     types.StringValue(pool.DisplayName),
+		Criteria: make([]GridStoragePoolCriterionModel, len(pool.Criteria)),
+	}
+
+	for i, criterion := range pool.Criteria {
+		model.Criteria[i] = GridStoragePoolCriterionModel{
+			SiteID:         stringOrNull(criterion.SiteID),
+			StorageGradeID: stringOrNull(criterion.StorageGradeID),
+		}
+	}
+
+	return model, nil
+}
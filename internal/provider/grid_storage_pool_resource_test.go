@@ -0,0 +1,72 @@
+// Copyright (c) github.com/dmpe
+// SPDX-License-Identifier: MIT
+
+package provider
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestGridStoragePoolResource(t *testing.T) {
+	gridProvider := gridProviderConfiguration(t)
+	siteID := gridSiteID(t)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"storagegrid": providerserver.NewProtocol6WithError(New("test")()),
+		},
+		Steps: []resource.TestStep{
+			// Create
+			{
+				Config: gridStoragePoolConfiguration(gridProvider, siteID, "tf-provider-acc-test"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("storagegrid_grid_storage_pool.test", "id"),
+					resource.TestCheckResourceAttr("storagegrid_grid_storage_pool.test", "criteria.0.site_id", siteID),
+					resource.TestCheckNoResourceAttr("storagegrid_grid_storage_pool.test", "criteria.0.storage_grade_id"),
+				),
+			},
+			// Update
+			{
+				Config: gridStoragePoolConfiguration(gridProvider, siteID, "tf-provider-acc-test-2"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("storagegrid_grid_storage_pool.test", "name", "tf-provider-acc-test-2"),
+				),
+			},
+			// Import
+			{
+				ResourceName:      "storagegrid_grid_storage_pool.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Delete testing is done automatically
+		},
+	})
+}
+
+// gridSiteID returns the ID of a site of the test grid, in which the ILM acceptance tests create a storage pool.
+func gridSiteID(t *testing.T) string {
+	siteID := os.Getenv("STORAGEGRID_GRID_SITE_ID")
+	if siteID == "" {
+		t.Skip("STORAGEGRID_GRID_SITE_ID must be set to test storage pools and ILM")
+	}
+	return siteID
+}
+
+func gridStoragePoolConfiguration(gridProvider string, siteID string, name string) string {
+	return gridProvider + fmt.Sprintf(`
+resource "storagegrid_grid_storage_pool" "test" {
+	name = "%s"
+
+	criteria = [
+		{
+			site_id = "%s"
+		},
+	]
+}`, name, siteID)
+}
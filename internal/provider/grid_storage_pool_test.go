@@ -0,0 +1,42 @@
+// Copyright (c) github.com/dmpe
+// SPDX-License-Identifier: MIT
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+)
+
+const gridStoragePoolResponse = `{"data": {
+	"id": "4f2a09c1", "displayName": "tf-provider-acc-test",
+	"criteria": [{"siteId": "site-1"}, {"siteId": "site-2", "storageGradeId": "grade-1"}]
+}}`
+
+func TestGridStoragePoolResourceModel_Create(t *testing.T) {
+	model := GridStoragePoolResourceModel{
+		ID:   types.StringUnknown(),
+		Name: types.StringValue("tf-provider-acc-test"),
+		Criteria: []GridStoragePoolCriterionModel{
+			{SiteID: types.StringValue("site-1"), StorageGradeID: types.StringNull()},
+			{SiteID: types.StringValue("site-2"), StorageGradeID: types.StringValue("grade-1")},
+		},
+	}
+
+	client := &stubHttpClient{responses: []stubResponse{
+		{body: gridStoragePoolResponse, code: 201},
+	}}
+
+	pool, err := model.create(client)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"POST /grid/storage-pools"}, client.requests)
+
+	payload := client.payloads[0].(*GridStoragePoolApiModel)
+	assert.Equal(t, "tf-provider-acc-test", payload.DisplayName)
+	assert.Equal(t, []GridStoragePoolCriterionApiModel{{SiteID: "site-1"}, {SiteID: "site-2", StorageGradeID: "grade-1"}}, payload.Criteria)
+
+	assert.Equal(t, "4f2a09c1", pool.ID.ValueString())
+	assert.Equal(t, model.Criteria, pool.Criteria)
+}
@@ -0,0 +1,148 @@
+// Copyright (c) github.com/dmpe
+// SPDX-License-Identifier: MIT
+
+package provider
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+const (
+	gridTenantCapabilityManagement = "management"
+	gridTenantCapabilityS3         = "s3"
+	gridTenantCapabilitySwift      = "swift"
+)
+
+type GridTenantResourceModel struct {
+	ID                       types.String   `tfsdk:"id"`
+	Name                     types.String   `tfsdk:"name"`
+	Capabilities             []types.String `tfsdk:"capabilities"`
+	QuotaObjectBytes         types.Int64    `tfsdk:"quota_object_bytes"`
+	UseAccountIdentitySource types.Bool     `tfsdk:"use_account_identity_source"`
+	AllowPlatformServices    types.Bool     `tfsdk:"allow_platform_services"`
+	AllowSelectObjectContent types.Bool     `tfsdk:"allow_select_object_content"`
+	RootPassword             types.String   `tfsdk:"root_password_wo"`
+}
+
+type GridTenantApiModel struct {
+	ID           string                   `json:"id,omitempty"`
+	Name         string                   `json:"name"`
+	Capabilities []string                 `json:"capabilities"`
+	Policy       GridTenantPolicyApiModel `json:"policy"`
+	Password     string                   `json:"password,omitempty"`
+}
+
+type GridTenantPolicyApiModel struct {
+	UseAccountIdentitySource bool `json:"useAccountIdentitySource"`
+	AllowPlatformServices    bool `json:"allowPlatformServices"`
+	AllowSelectObjectContent bool `json:"allowSelectObjectContent"`
+	// a missing quota means that the tenant is not limited
+	QuotaObjectBytes *int64 `json:"quotaObjectBytes"`
+}
+
+func (m *GridTenantResourceModel) toGridTenantApiModel() *GridTenantApiModel {
+	return &GridTenantApiModel{
+		ID:           m.ID.ValueString(),
+		Name:         m.Name.ValueString(),
+		Capabilities: toJson(m.Capabilities),
+		Policy: GridTenantPolicyApiModel{
+			UseAccountIdentitySource: m.UseAccountIdentitySource.ValueBool(),
+			AllowPlatformServices:    m.AllowPlatformServices.ValueBool(),
+			AllowSelectObjectContent: m.AllowSelectObjectContent.ValueBool(),
+			QuotaObjectBytes:         m.QuotaObjectBytes.ValueInt64Pointer(),
+		},
+	}
+}
+
+// withSecrets copies the write-only root password of the configuration into the plan.
+func (m *GridTenantResourceModel) withSecrets(config *GridTenantResourceModel) {
+	m.RootPassword = config.RootPassword
+}
+
+// create creates the tenant account. The root password is only sent here, it is the initial password of the local
+// root user of the tenant.
+func (m *GridTenantResourceModel) create(client HttpClient) (*GridTenantResourceModel, error) {
+	payload := m.toGridTenantApiModel()
+	payload.Password = m.RootPassword.ValueString()
+
+	respBody, _, _, err := client.SendRequest("POST", api_grid_accounts, payload, 201)
+	if err != nil {
+		return nil, fmt.Errorf("unable to create tenant account: %w", err)
+	}
+
+	return NewGridTenantResourceModel(respBody)
+}
+
+func (m *GridTenantResourceModel) read(client HttpClient) (*GridTenantResourceModel, error) {
+	endpoint := fmt.Sprintf("%s/%s", api_grid_accounts, m.ID.ValueString())
+	respBody, _, respCode, err := client.SendRequest("GET", endpoint, nil, 200)
+	if err != nil {
+		if respCode == http.StatusNotFound {
+			return nil, ErrGridTenantNotFound
+		}
+		return nil, fmt.Errorf("unable to read tenant account: %w", err)
+	}
+
+	return NewGridTenantResourceModel(respBody)
+}
+
+func (m *GridTenantResourceModel) update(client HttpClient) (*GridTenantResourceModel, error) {
+	endpoint := fmt.Sprintf("%s/%s", api_grid_accounts, m.ID.ValueString())
+	respBody, _, respCode, err := client.SendRequest("PUT", endpoint, m.toGridTenantApiModel(), 200)
+	if err != nil {
+		if respCode == http.StatusNotFound {
+			return nil, ErrGridTenantNotFound
+		}
+		return nil, fmt.Errorf("unable to update tenant account: %w", err)
+	}
+
+	return NewGridTenantResourceModel(respBody)
+}
+
+func (m *GridTenantResourceModel) delete(client HttpClient) error {
+	endpoint := fmt.Sprintf("%s/%s", api_grid_accounts, m.ID.ValueString())
+	_, _, respCode, err := client.SendRequest("DELETE", endpoint, nil, 204)
+	if err != nil {
+		if respCode == http.StatusNotFound {
+			return ErrGridTenantNotFound
+		}
+		return fmt.Errorf("unable to delete tenant account: %w", err)
+	}
+
+	return nil
+}
+
+// NewGridTenantResourceModel parses the JSON response from the API into a GridTenantResourceModel. The root password
+// is never returned by the API, hence it is always null.
+func NewGridTenantResourceModel(input []byte) (*GridTenantResourceModel, error) {
+	type responseDataType struct {
+		Data GridTenantApiModel `json:"data"`
+	}
+
+	var returnBody responseDataType
+	if err := json.Unmarshal(input, &returnBody); err != nil {
+		return nil, &GenericError{Summary: "Client Error", Details: "Unable to parse tenant account response, got error: " + err.Error()}
+	}
+
+	tenant := returnBody.Data
+
+	// the order of the capabilities is not significant
+	capabilities := append([]string{}, tenant.Capabilities...)
+	sort.Strings(capabilities)
+
+	return &GridTenantResourceModel{
+		ID:                       types.StringValue(tenant.ID),
+		Name:                     types.StringValue(tenant.Name),
+		Capabilities:             toTerraform(capabilities),
+		QuotaObjectBytes:         types.Int64PointerValue(tenant.Policy.QuotaObjectBytes),
+		UseAccountIdentitySource: types.BoolValue(tenant.Policy.UseAccountIdentitySource),
+		AllowPlatformServices:    types.BoolValue(tenant.Policy.AllowPlatformServices),
+		AllowSelectObjectContent: types.BoolValue(tenant.Policy.AllowSelectObjectContent),
+		RootPassword:             types.StringNull(),
+	}, nil
+}
@@ -0,0 +1,216 @@
+// Copyright (c) github.com/dmpe
+// SPDX-License-Identifier: MIT
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider-defined types fully satisfy framework interfaces.
+var (
+	_ resource.Resource                = &gridTenantResource{}
+	_ resource.ResourceWithConfigure   = &gridTenantResource{}
+	_ resource.ResourceWithImportState = &gridTenantResource{}
+)
+
+// NewGridTenantResource returns a new resource instance.
+func NewGridTenantResource() resource.Resource {
+	return &gridTenantResource{}
+}
+
+type gridTenantResource struct {
+	client *S3GridClient
+}
+
+func (r *gridTenantResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_grid_tenant"
+}
+
+func (r *gridTenantResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `
+Manage a tenant account of the grid through the Grid Management API.
+
+**Note:**
+- The provider must authenticate as grid administrator, i.e. with 'grid_admin = true'.
+- The root password is write-only (Terraform 1.11 or later is required) and never stored in the state. It is only used as the initial password of the local root user when the tenant is created, changing it afterwards has no effect.
+- Removing this resource deletes the tenant account. StorageGRID refuses to delete a tenant which still has buckets.
+- Import by the account ID of the tenant.
+`,
+		Attributes: map[string]schema.Attribute{
+			id: schema.StringAttribute{
+				Computed:    true,
+				Description: "The account ID of the tenant.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Required:    true,
+				Description: "The display name of the tenant.",
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 256),
+				},
+			},
+			"capabilities": schema.SetAttribute{
+				ElementType: types.StringType,
+				Required:    true,
+				Description: "The capabilities of the tenant. Can be 'management', 's3' and 'swift', but not both 's3' and 'swift'.",
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+					setvalidator.ValueStringsAre(
+						stringvalidator.OneOf(gridTenantCapabilityManagement, gridTenantCapabilityS3, gridTenantCapabilitySwift),
+					),
+				},
+			},
+			"quota_object_bytes": schema.Int64Attribute{
+				Optional:    true,
+				Description: "The maximum number of bytes the tenant may store. The tenant is not limited if omitted.",
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+			"use_account_identity_source": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Whether the tenant uses its own identity source, see 'storagegrid_identity_source', instead of the identity source of the grid. Defaults to 'true'.",
+				Default:     booldefault.StaticBool(true),
+			},
+			"allow_platform_services": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Whether the tenant may use platform services, e.g. 'storagegrid_endpoint'. Defaults to 'false'.",
+				Default:     booldefault.StaticBool(false),
+			},
+			"allow_select_object_content": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Whether the tenant may use S3 SelectObjectContent. Defaults to 'false'.",
+				Default:     booldefault.StaticBool(false),
+			},
+			"root_password_wo": schema.StringAttribute{
+				Optional:    true,
+				Sensitive:   true,
+				WriteOnly:   true,
+				Description: "The initial password of the local root user of the tenant.",
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(8, 32),
+				},
+			},
+		},
+	}
+}
+
+func (r *gridTenantResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*S3GridClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	resp.Diagnostics.Append(requireGridScope(client)...)
+
+	r.client = client
+}
+
+func (r *gridTenantResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan, config GridTenantResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan.withSecrets(&config)
+
+	tenant, err := plan.create(r.client)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, tenant)...)
+}
+
+func (r *gridTenantResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state GridTenantResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	read, err := state.read(r.client)
+	if err != nil {
+		if errors.Is(err, ErrGridTenantNotFound) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Error Reading StorageGrid tenant account", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, read)...)
+}
+
+func (r *gridTenantResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan GridTenantResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tenant, err := plan.update(r.client)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, tenant)...)
+}
+
+func (r *gridTenantResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state GridTenantResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := state.delete(r.client)
+	if err != nil && !errors.Is(err, ErrGridTenantNotFound) {
+		resp.Diagnostics.AddError("Error Deleting StorageGrid tenant account", err.Error())
+		return
+	}
+}
+
+func (r *gridTenantResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root(id), req, resp)
+}
@@ -0,0 +1,88 @@
+// Copyright (c) github.com/dmpe
+// SPDX-License-Identifier: MIT
+
+package provider
+
+import (
+	"fmt"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestGridTenantResource(t *testing.T) {
+	gridProvider := gridProviderConfiguration(t)
+	name := fmt.Sprintf("tf-provider-acc-test-%d", time.Now().Unix())
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"storagegrid": providerserver.NewProtocol6WithError(New("test")()),
+		},
+		// write-only attributes require Terraform 1.11
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_11_0),
+		},
+		Steps: []resource.TestStep{
+			// Create
+			{
+				Config: gridTenantConfiguration(gridProvider, name, "false"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("storagegrid_grid_tenant.test", "id"),
+					resource.TestCheckResourceAttr("storagegrid_grid_tenant.test", "name", name),
+					resource.TestCheckResourceAttr("storagegrid_grid_tenant.test", "capabilities.#", "2"),
+					resource.TestCheckResourceAttr("storagegrid_grid_tenant.test", "quota_object_bytes", "1000000000"),
+					resource.TestCheckResourceAttr("storagegrid_grid_tenant.test", "allow_platform_services", "false"),
+					resource.TestCheckNoResourceAttr("storagegrid_grid_tenant.test", "root_password_wo"),
+				),
+			},
+			// Update
+			{
+				Config: gridTenantConfiguration(gridProvider, name, "true"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("storagegrid_grid_tenant.test", "allow_platform_services", "true"),
+				),
+			},
+			// Import
+			{
+				ResourceName:      "storagegrid_grid_tenant.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Delete testing is done automatically
+		},
+	})
+}
+
+// gridProviderConfiguration returns the provider block of a grid administrator. The test is skipped unless the
+// credentials of a grid administrator are set, as the tests usually run against a tenant.
+func gridProviderConfiguration(t *testing.T) string {
+	username := os.Getenv("STORAGEGRID_GRID_USERNAME")
+	password := os.Getenv("STORAGEGRID_GRID_PASSWORD")
+	if username == "" || password == "" {
+		t.Skip("STORAGEGRID_GRID_USERNAME and STORAGEGRID_GRID_PASSWORD must be set to test grid resources")
+	}
+
+	return fmt.Sprintf(`
+provider "storagegrid" {
+	username   = "%s"
+	password   = "%s"
+	grid_admin = true
+}
+`, username, password)
+}
+
+func gridTenantConfiguration(gridProvider string, name string, allowPlatformServices string) string {
+	return gridProvider + fmt.Sprintf(`
+resource "storagegrid_grid_tenant" "test" {
+	name                    = "%s"
+	capabilities            = ["management", "s3"]
+	quota_object_bytes      = 1000000000
+	allow_platform_services = %s
+	root_password_wo        = "tf-provider-acc-test"
+}`, name, allowPlatformServices)
+}
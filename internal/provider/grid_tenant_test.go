@@ -0,0 +1,70 @@
+// Copyright (c) github.com/dmpe
+// SPDX-License-Identifier: MIT
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+)
+
+const gridTenantResponse = `{"data": {
+	"id": "12345678901234567890", "name": "tf-provider-acc-test", "capabilities": ["s3", "management"],
+	"policy": {"useAccountIdentitySource": true, "allowPlatformServices": true, "allowSelectObjectContent": false, "quotaObjectBytes": null}
+}}`
+
+func newTestGridTenantResourceModel() GridTenantResourceModel {
+	return GridTenantResourceModel{
+		ID:                       types.StringUnknown(),
+		Name:                     types.StringValue("tf-provider-acc-test"),
+		Capabilities:             []types.String{types.StringValue("s3"), types.StringValue("management")},
+		QuotaObjectBytes:         types.Int64Null(),
+		UseAccountIdentitySource: types.BoolValue(true),
+		AllowPlatformServices:    types.BoolValue(true),
+		AllowSelectObjectContent: types.BoolValue(false),
+		RootPassword:             types.StringValue("change-me-now"),
+	}
+}
+
+func TestGridTenantResourceModel_Create(t *testing.T) {
+	model := newTestGridTenantResourceModel()
+
+	client := &stubHttpClient{responses: []stubResponse{
+		{body: gridTenantResponse, code: 201},
+	}}
+
+	tenant, err := model.create(client)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"POST /grid/accounts"}, client.requests)
+
+	payload := client.payloads[0].(*GridTenantApiModel)
+	assert.Equal(t, "change-me-now", payload.Password)
+	assert.Nil(t, payload.Policy.QuotaObjectBytes)
+
+	assert.Equal(t, "12345678901234567890", tenant.ID.ValueString())
+	assert.Equal(t, []types.String{types.StringValue("management"), types.StringValue("s3")}, tenant.Capabilities)
+	assert.True(t, tenant.QuotaObjectBytes.IsNull())
+	assert.True(t, tenant.AllowPlatformServices.ValueBool())
+	assert.True(t, tenant.RootPassword.IsNull())
+}
+
+func TestGridTenantResourceModel_Update(t *testing.T) {
+	model := newTestGridTenantResourceModel()
+	model.ID = types.StringValue("12345678901234567890")
+	model.QuotaObjectBytes = types.Int64Value(1000000000)
+
+	client := &stubHttpClient{responses: []stubResponse{
+		{body: gridTenantResponse, code: 200},
+	}}
+
+	_, err := model.update(client)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"PUT /grid/accounts/12345678901234567890"}, client.requests)
+
+	// the root password is only sent on create
+	payload := client.payloads[0].(*GridTenantApiModel)
+	assert.Empty(t, payload.Password)
+	assert.Equal(t, int64(1000000000), *payload.Policy.QuotaObjectBytes)
+}
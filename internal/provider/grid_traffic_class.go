@@ -0,0 +1,253 @@
+// Copyright (c) github.com/dmpe
+// SPDX-License-Identifier: MIT
+
+package provider
+
+import (
+	"encoding/json"
+	"fmt"
+	"net"
+	"net/http"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+const (
+	trafficClassMatcherTenant               = "tenant"
+	trafficClassMatcherBucket               = "bucket"
+	trafficClassMatcherSubnet               = "subnet"
+	trafficClassMatcherLoadBalancerEndpoint = "load-balancer-endpoint"
+)
+
+// trafficClassMatcherTypes maps the matcher types of the resource to the matcher types of the API.
+var trafficClassMatcherTypes = map[string]string{
+	trafficClassMatcherTenant:               "tenant",
+	trafficClassMatcherBucket:               "bucket",
+	trafficClassMatcherSubnet:               "cidr",
+	trafficClassMatcherLoadBalancerEndpoint: "endpoint",
+}
+
+type GridTrafficClassResourceModel struct {
+	ID          types.String                   `tfsdk:"id"`
+	Name        types.String                   `tfsdk:"name"`
+	Description types.String                   `tfsdk:"description"`
+	Matchers    []GridTrafficClassMatcherModel `tfsdk:"matchers"`
+	Limits      *GridTrafficClassLimitsModel   `tfsdk:"limits"`
+}
+
+// GridTrafficClassMatcherModel matches the requests of the given tenants, buckets, client subnets or load balancer
+// endpoints. An inverse matcher matches all other requests.
+type GridTrafficClassMatcherModel struct {
+	Type    types.String   `tfsdk:"type"`
+	Members []types.String `tfsdk:"members"`
+	Inverse types.Bool     `tfsdk:"inverse"`
+}
+
+// GridTrafficClassLimitsModel holds the limits of the matched requests. Bandwidths are in bytes per second, request
+// rates in requests per second.
+type GridTrafficClassLimitsModel struct {
+	AggregateBandwidthIn    types.Int64 `tfsdk:"aggregate_bandwidth_in"`
+	AggregateBandwidthOut   types.Int64 `tfsdk:"aggregate_bandwidth_out"`
+	PerRequestBandwidthIn   types.Int64 `tfsdk:"per_request_bandwidth_in"`
+	PerRequestBandwidthOut  types.Int64 `tfsdk:"per_request_bandwidth_out"`
+	ConcurrentReadRequests  types.Int64 `tfsdk:"concurrent_read_requests"`
+	ConcurrentWriteRequests types.Int64 `tfsdk:"concurrent_write_requests"`
+	ReadRequestRate         types.Int64 `tfsdk:"read_request_rate"`
+	WriteRequestRate        types.Int64 `tfsdk:"write_request_rate"`
+}
+
+type GridTrafficClassApiModel struct {
+	ID          string                            `json:"id,omitempty"`
+	Name        string                            `json:"name"`
+	Description string                            `json:"description,omitempty"`
+	Matchers    []GridTrafficClassMatcherApiModel `json:"matchers"`
+	Limits      []GridTrafficClassLimitApiModel   `json:"limits"`
+}
+
+type GridTrafficClassMatcherApiModel struct {
+	Type    string   `json:"type"`
+	Inverse bool     `json:"inverse"`
+	Members []string `json:"members"`
+}
+
+type GridTrafficClassLimitApiModel struct {
+	Type  string `json:"type"`
+	Value int64  `json:"value"`
+}
+
+// limits returns the limits keyed by their type in the API.
+func (m *GridTrafficClassLimitsModel) limits() map[string]*types.Int64 {
+	return map[string]*types.Int64{
+		"aggregateBandwidthIn":    &m.AggregateBandwidthIn,
+		"aggregateBandwidthOut":   &m.AggregateBandwidthOut,
+		"perRequestBandwidthIn":   &m.PerRequestBandwidthIn,
+		"perRequestBandwidthOut":  &m.PerRequestBandwidthOut,
+		"concurrentReadRequests":  &m.ConcurrentReadRequests,
+		"concurrentWriteRequests": &m.ConcurrentWriteRequests,
+		"readRequestRate":         &m.ReadRequestRate,
+		"writeRequestRate":        &m.WriteRequestRate,
+	}
+}
+
+func (m *GridTrafficClassResourceModel) toGridTrafficClassApiModel() *GridTrafficClassApiModel {
+	policy := &GridTrafficClassApiModel{
+		ID:          m.ID.ValueString(),
+		Name:        m.Name.ValueString(),
+		Description: m.Description.ValueString(),
+		Matchers:    make([]GridTrafficClassMatcherApiModel, len(m.Matchers)),
+		Limits:      []GridTrafficClassLimitApiModel{},
+	}
+
+	for i, matcher := range m.Matchers {
+		policy.Matchers[i] = GridTrafficClassMatcherApiModel{
+			Type:    trafficClassMatcherTypes[matcher.Type.ValueString()],
+			Inverse: matcher.Inverse.ValueBool(),
+			Members: toJson(matcher.Members),
+		}
+	}
+
+	if m.Limits != nil {
+		for limitType, value := range m.Limits.limits() {
+			if !value.IsNull() {
+				policy.Limits = append(policy.Limits, GridTrafficClassLimitApiModel{Type: limitType, Value: value.ValueInt64()})
+			}
+		}
+		// map iteration is random, keep the payload stable
+		sort.Slice(policy.Limits, func(i, j int) bool { return policy.Limits[i].Type < policy.Limits[j].Type })
+	}
+
+	return policy
+}
+
+func (m *GridTrafficClassResourceModel) create(client HttpClient) (*GridTrafficClassResourceModel, error) {
+	respBody, _, _, err := client.SendRequest("POST", api_grid_traffic_classes, m.toGridTrafficClassApiModel(), 201)
+	if err != nil {
+		return nil, fmt.Errorf("unable to create traffic classification policy: %w", err)
+	}
+
+	return NewGridTrafficClassResourceModel(respBody)
+}
+
+func (m *GridTrafficClassResourceModel) read(client HttpClient) (*GridTrafficClassResourceModel, error) {
+	endpoint := fmt.Sprintf("%s/%s", api_grid_traffic_classes, m.ID.ValueString())
+	respBody, _, respCode, err := client.SendRequest("GET", endpoint, nil, 200)
+	if err != nil {
+		if respCode == http.StatusNotFound {
+			return nil, ErrGridTrafficClassNotFound
+		}
+		return nil, fmt.Errorf("unable to read traffic classification policy: %w", err)
+	}
+
+	return NewGridTrafficClassResourceModel(respBody)
+}
+
+func (m *GridTrafficClassResourceModel) update(client HttpClient) (*GridTrafficClassResourceModel, error) {
+	endpoint := fmt.Sprintf("%s/%s", api_grid_traffic_classes, m.ID.ValueString())
+	respBody, _, respCode, err := client.SendRequest("PUT", endpoint, m.toGridTrafficClassApiModel(), 200)
+	if err != nil {
+		if respCode == http.StatusNotFound {
+			return nil, ErrGridTrafficClassNotFound
+		}
+		return nil, fmt.Errorf("unable to update traffic classification policy: %w", err)
+	}
+
+	return NewGridTrafficClassResourceModel(respBody)
+}
+
+func (m *GridTrafficClassResourceModel) delete(client HttpClient) error {
+	endpoint := fmt.Sprintf("%s/%s", api_grid_traffic_classes, m.ID.ValueString())
+	_, _, respCode, err := client.SendRequest("DELETE", endpoint, nil, 204)
+	if err != nil {
+		if respCode == http.StatusNotFound {
+			return ErrGridTrafficClassNotFound
+		}
+		return fmt.Errorf("unable to delete traffic classification policy: %w", err)
+	}
+
+	return nil
+}
+
+// NewGridTrafficClassResourceModel parses the JSON response from the API into a GridTrafficClassResourceModel. Limits
+// of unknown types are ignored.
+func NewGridTrafficClassResourceModel(input []byte) (*GridTrafficClassResourceModel, error) {
+	type responseDataType struct {
+		Data GridTrafficClassApiModel `json:"data"`
+	}
+
+	var returnBody responseDataType
+	if err := json.Unmarshal(input, &returnBody); err != nil {
+		return nil, &GenericError{Summary: "Client Error", Details: "Unable to parse traffic classification policy response, got error: " + err.Error()}
+	}
+
+	policy := returnBody.Data
+	model := &GridTrafficClassResourceModel{
+		ID:          types.StringValue(policy.ID),
+		Name:        types.StringValue(policy.Name),
+		Description: stringOrNull(policy.Description),
+		Matchers:    make([]GridTrafficClassMatcherModel, len(policy.Matchers)),
+	}
+
+	for i, matcher := range policy.Matchers {
+		model.Matchers[i] = GridTrafficClassMatcherModel{
+			Type:    types.StringValue(reverseLookup(trafficClassMatcherTypes, matcher.Type)),
+			Members: toTerraform(matcher.Members),
+			Inverse: types.BoolValue(matcher.Inverse),
+		}
+	}
+
+	if len(policy.Limits) > 0 {
+		model.Limits = &GridTrafficClassLimitsModel{}
+		limits := model.Limits.limits()
+		for _, value := range limits {
+			*value = types.Int64Null()
+		}
+		for _, limit := range policy.Limits {
+			if value, ok := limits[limit.Type]; ok {
+				*value = types.Int64Value(limit.Value)
+			}
+		}
+	}
+
+	return model, nil
+}
+
+// validate checks the CIDRs of the subnet matchers, and that the limits are not empty.
+func (m *GridTrafficClassResourceModel) validate() diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	for i, matcher := range m.Matchers {
+		if matcher.Type.IsUnknown() || matcher.Type.ValueString() != trafficClassMatcherSubnet {
+			continue
+		}
+		for j, member := range matcher.Members {
+			if member.IsUnknown() {
+				continue
+			}
+			if _, _, err := net.ParseCIDR(member.ValueString()); err != nil {
+				diags.AddAttributeError(
+					path.Root("matchers").AtListIndex(i).AtName("members").AtListIndex(j),
+					"Invalid traffic class subnet",
+					fmt.Sprintf("'%s' is not a subnet in CIDR notation, e.g. '10.0.0.0/24'.", member.ValueString()),
+				)
+			}
+		}
+	}
+
+	if m.Limits != nil {
+		empty := true
+		for _, value := range m.Limits.limits() {
+			if !value.IsNull() {
+				empty = false
+			}
+		}
+		if empty {
+			diags.AddAttributeError(path.Root("limits"), "Empty traffic class limits",
+				"The 'limits' require at least one limit. Remove them to only monitor the matched requests.")
+		}
+	}
+
+	return diags
+}
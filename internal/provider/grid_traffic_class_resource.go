@@ -0,0 +1,246 @@
+// Copyright (c) github.com/dmpe
+// SPDX-License-Identifier: MIT
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider-defined types fully satisfy framework interfaces.
+var (
+	_ resource.Resource                   = &gridTrafficClassResource{}
+	_ resource.ResourceWithConfigure      = &gridTrafficClassResource{}
+	_ resource.ResourceWithImportState    = &gridTrafficClassResource{}
+	_ resource.ResourceWithValidateConfig = &gridTrafficClassResource{}
+)
+
+// NewGridTrafficClassResource returns a new resource instance.
+func NewGridTrafficClassResource() resource.Resource {
+	return &gridTrafficClassResource{}
+}
+
+type gridTrafficClassResource struct {
+	client *S3GridClient
+}
+
+func (r *gridTrafficClassResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_grid_traffic_class"
+}
+
+// trafficClassLimitAttribute returns the schema of an optional limit of a traffic classification policy.
+func trafficClassLimitAttribute(description string) schema.Int64Attribute {
+	return schema.Int64Attribute{
+		Optional:    true,
+		Description: description,
+		Validators: []validator.Int64{
+			int64validator.AtLeast(1),
+		},
+	}
+}
+
+func (r *gridTrafficClassResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `
+Manage a traffic classification policy of the grid. The policy matches S3 and Swift requests, e.g. of a tenant or bucket, and limits their bandwidth and request rate.
+
+**Note:**
+- The provider must authenticate as grid administrator, i.e. with 'grid_admin = true'.
+- A request matches the policy if it matches any of the matchers.
+- A policy without limits only monitors the matched requests.
+- Import by the ID of the traffic classification policy.
+`,
+		Attributes: map[string]schema.Attribute{
+			id: schema.StringAttribute{
+				Computed:    true,
+				Description: "The unique identifier of the traffic classification policy.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Required:    true,
+				Description: "The name of the traffic classification policy.",
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 64),
+				},
+			},
+			"description": schema.StringAttribute{
+				Optional:    true,
+				Description: "The description of the traffic classification policy.",
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 128),
+				},
+			},
+			"matchers": schema.ListNestedAttribute{
+				Required:    true,
+				Description: "The matchers which select the requests the policy applies to.",
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+				},
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"type": schema.StringAttribute{
+							Required: true,
+							Description: "The type of the matcher. Can be 'tenant' (account IDs, e.g. from 'storagegrid_grid_tenant'), 'bucket' (bucket names, e.g. from 'storagegrid_bucket'), " +
+								"'subnet' (client subnets in CIDR notation) or 'load-balancer-endpoint' (IDs from 'storagegrid_grid_load_balancer_endpoint').",
+							Validators: []validator.String{
+								stringvalidator.OneOf(trafficClassMatcherTenant, trafficClassMatcherBucket, trafficClassMatcherSubnet, trafficClassMatcherLoadBalancerEndpoint),
+							},
+						},
+						"members": schema.ListAttribute{
+							ElementType: types.StringType,
+							Required:    true,
+							Description: "The tenant account IDs, bucket names, subnets or load balancer endpoint IDs to match, depending on the type.",
+							Validators: []validator.List{
+								listvalidator.SizeAtLeast(1),
+								listvalidator.UniqueValues(),
+							},
+						},
+						"inverse": schema.BoolAttribute{
+							Optional:    true,
+							Computed:    true,
+							Description: "Match all requests except the ones of the members. Defaults to 'false'.",
+							Default:     booldefault.StaticBool(false),
+						},
+					},
+				},
+			},
+			"limits": schema.SingleNestedAttribute{
+				Optional:    true,
+				Description: "The limits of the matched requests. Bandwidths are in bytes per second and apply to the whole grid, or to each request.",
+				Attributes: map[string]schema.Attribute{
+					"aggregate_bandwidth_in":    trafficClassLimitAttribute("The bandwidth of all matched uploads together."),
+					"aggregate_bandwidth_out":   trafficClassLimitAttribute("The bandwidth of all matched downloads together."),
+					"per_request_bandwidth_in":  trafficClassLimitAttribute("The bandwidth of each matched upload."),
+					"per_request_bandwidth_out": trafficClassLimitAttribute("The bandwidth of each matched download."),
+					"concurrent_read_requests":  trafficClassLimitAttribute("The number of concurrent matched read requests."),
+					"concurrent_write_requests": trafficClassLimitAttribute("The number of concurrent matched write requests."),
+					"read_request_rate":         trafficClassLimitAttribute("The number of matched read requests per second."),
+					"write_request_rate":        trafficClassLimitAttribute("The number of matched write requests per second."),
+				},
+			},
+		},
+	}
+}
+
+func (r *gridTrafficClassResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*S3GridClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	resp.Diagnostics.Append(requireGridScope(client)...)
+
+	r.client = client
+}
+
+func (r *gridTrafficClassResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config GridTrafficClassResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(config.validate()...)
+}
+
+func (r *gridTrafficClassResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan GridTrafficClassResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	policy, err := plan.create(r.client)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, policy)...)
+}
+
+func (r *gridTrafficClassResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state GridTrafficClassResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	read, err := state.read(r.client)
+	if err != nil {
+		if errors.Is(err, ErrGridTrafficClassNotFound) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Error Reading StorageGrid traffic classification policy", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, read)...)
+}
+
+func (r *gridTrafficClassResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan GridTrafficClassResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	policy, err := plan.update(r.client)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, policy)...)
+}
+
+func (r *gridTrafficClassResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state GridTrafficClassResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := state.delete(r.client)
+	if err != nil && !errors.Is(err, ErrGridTrafficClassNotFound) {
+		resp.Diagnostics.AddError("Error Deleting StorageGrid traffic classification policy", err.Error())
+		return
+	}
+}
+
+func (r *gridTrafficClassResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root(id), req, resp)
+}
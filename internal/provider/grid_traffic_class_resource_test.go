@@ -0,0 +1,94 @@
+// Copyright (c) github.com/dmpe
+// SPDX-License-Identifier: MIT
+
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestGridTrafficClassResource(t *testing.T) {
+	gridProvider := gridProviderConfiguration(t)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"storagegrid": providerserver.NewProtocol6WithError(New("test")()),
+		},
+		Steps: []resource.TestStep{
+			// Subnet without prefix length
+			{
+				Config: gridProvider + `
+resource "storagegrid_grid_traffic_class" "test" {
+	name = "tf-provider-acc-test"
+
+	matchers = [
+		{
+			type    = "subnet"
+			members = ["10.0.0.1"]
+		},
+	]
+}`,
+				ExpectError: regexp.MustCompile("Invalid traffic class subnet"),
+			},
+			// Create
+			{
+				Config: gridTrafficClassConfiguration(gridProvider, 100),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("storagegrid_grid_traffic_class.test", "id"),
+					resource.TestCheckResourceAttr("storagegrid_grid_traffic_class.test", "matchers.0.type", "tenant"),
+					resource.TestCheckResourceAttrPair("storagegrid_grid_traffic_class.test", "matchers.0.members.0", "storagegrid_grid_tenant.test", "id"),
+					resource.TestCheckResourceAttr("storagegrid_grid_traffic_class.test", "matchers.1.inverse", "false"),
+					resource.TestCheckResourceAttr("storagegrid_grid_traffic_class.test", "limits.read_request_rate", "100"),
+				),
+			},
+			// Update
+			{
+				Config: gridTrafficClassConfiguration(gridProvider, 200),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("storagegrid_grid_traffic_class.test", "limits.read_request_rate", "200"),
+				),
+			},
+			// Import
+			{
+				ResourceName:      "storagegrid_grid_traffic_class.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Delete testing is done automatically
+		},
+	})
+}
+
+func gridTrafficClassConfiguration(gridProvider string, readRequestRate int) string {
+	return gridProvider + fmt.Sprintf(`
+resource "storagegrid_grid_tenant" "test" {
+	name         = "tf-provider-acc-test-traffic-class"
+	capabilities = ["s3"]
+}
+
+resource "storagegrid_grid_traffic_class" "test" {
+	name = "tf-provider-acc-test"
+
+	matchers = [
+		{
+			type    = "tenant"
+			members = [storagegrid_grid_tenant.test.id]
+		},
+		{
+			type    = "subnet"
+			members = ["10.0.0.0/24"]
+		},
+	]
+
+	limits = {
+		aggregate_bandwidth_out = 100000000
+		read_request_rate       = %d
+	}
+}`, readRequestRate)
+}
@@ -0,0 +1,107 @@
+// Copyright (c) github.com/dmpe
+// SPDX-License-Identifier: MIT
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+)
+
+const gridTrafficClassResponse = `{"data": {
+	"id": "5e7b2c90", "name": "tf-provider-acc-test",
+	"matchers": [
+		{"type": "tenant", "inverse": false, "members": ["27983238148563830000"]},
+		{"type": "cidr", "inverse": true, "members": ["10.0.0.0/24"]}
+	],
+	"limits": [{"type": "aggregateBandwidthIn", "value": 1000000}, {"type": "readRequestRate", "value": 100}, {"type": "futureLimit", "value": 1}]
+}}`
+
+func newTestGridTrafficClassResourceModel() GridTrafficClassResourceModel {
+	return GridTrafficClassResourceModel{
+		ID:          types.StringUnknown(),
+		Name:        types.StringValue("tf-provider-acc-test"),
+		Description: types.StringNull(),
+		Matchers: []GridTrafficClassMatcherModel{
+			{Type: types.StringValue("tenant"), Members: []types.String{types.StringValue("27983238148563830000")}, Inverse: types.BoolValue(false)},
+			{Type: types.StringValue("subnet"), Members: []types.String{types.StringValue("10.0.0.0/24")}, Inverse: types.BoolValue(true)},
+		},
+		Limits: &GridTrafficClassLimitsModel{
+			AggregateBandwidthIn:    types.Int64Value(1000000),
+			AggregateBandwidthOut:   types.Int64Null(),
+			PerRequestBandwidthIn:   types.Int64Null(),
+			PerRequestBandwidthOut:  types.Int64Null(),
+			ConcurrentReadRequests:  types.Int64Null(),
+			ConcurrentWriteRequests: types.Int64Null(),
+			ReadRequestRate:         types.Int64Value(100),
+			WriteRequestRate:        types.Int64Null(),
+		},
+	}
+}
+
+func TestGridTrafficClassResourceModel_Create(t *testing.T) {
+	model := newTestGridTrafficClassResourceModel()
+
+	client := &stubHttpClient{responses: []stubResponse{
+		{body: gridTrafficClassResponse, code: 201},
+	}}
+
+	policy, err := model.create(client)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"POST /grid/traffic-classes/policies"}, client.requests)
+
+	payload := client.payloads[0].(*GridTrafficClassApiModel)
+	assert.Equal(t, GridTrafficClassMatcherApiModel{Type: "cidr", Inverse: true, Members: []string{"10.0.0.0/24"}}, payload.Matchers[1])
+	assert.Equal(t, []GridTrafficClassLimitApiModel{{Type: "aggregateBandwidthIn", Value: 1000000}, {Type: "readRequestRate", Value: 100}}, payload.Limits)
+
+	// the unknown limit type is ignored
+	model.ID = types.StringValue("5e7b2c90")
+	assert.Equal(t, &model, policy)
+}
+
+func TestGridTrafficClassResourceModel_WithoutLimits(t *testing.T) {
+	model := newTestGridTrafficClassResourceModel()
+	model.Limits = nil
+
+	// a policy without limits only monitors the requests
+	assert.Equal(t, []GridTrafficClassLimitApiModel{}, model.toGridTrafficClassApiModel().Limits)
+
+	policy, err := NewGridTrafficClassResourceModel([]byte(`{"data": {"id": "5e7b2c90", "name": "monitor", "matchers": [], "limits": []}}`))
+	assert.NoError(t, err)
+	assert.Nil(t, policy.Limits)
+}
+
+func TestGridTrafficClassResourceModel_Validate(t *testing.T) {
+	invalidSubnet := newTestGridTrafficClassResourceModel()
+	invalidSubnet.Matchers[1].Members = []types.String{types.StringValue("10.0.0.1")}
+
+	emptyLimits := newTestGridTrafficClassResourceModel()
+	emptyLimits.Limits.AggregateBandwidthIn = types.Int64Null()
+	emptyLimits.Limits.ReadRequestRate = types.Int64Null()
+
+	unknown := newTestGridTrafficClassResourceModel()
+	unknown.Matchers[1].Members = []types.String{types.StringUnknown()}
+
+	tests := []struct {
+		name   string
+		model  GridTrafficClassResourceModel
+		errors []string
+	}{
+		{"valid", newTestGridTrafficClassResourceModel(), nil},
+		{"invalid subnet", invalidSubnet, []string{"Invalid traffic class subnet"}},
+		{"empty limits", emptyLimits, []string{"Empty traffic class limits"}},
+		{"unknown values", unknown, nil},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var summaries []string
+			for _, d := range test.model.validate() {
+				summaries = append(summaries, d.Summary())
+			}
+			assert.Equal(t, test.errors, summaries)
+		})
+	}
+}
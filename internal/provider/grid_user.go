@@ -0,0 +1,105 @@
+// Copyright (c) github.com/dmpe
+// SPDX-License-Identifier: MIT
+
+package provider
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type GridUserResourceModel struct {
+	ID         types.String   `tfsdk:"id"`
+	UniqueName types.String   `tfsdk:"unique_name"`
+	FullName   types.String   `tfsdk:"full_name"`
+	MemberOf   []types.String `tfsdk:"member_of"`
+	Disable    types.Bool     `tfsdk:"disable"`
+	Federated  types.Bool     `tfsdk:"federated"`
+	UserURN    types.String   `tfsdk:"user_urn"`
+}
+
+// The grid users have the same API model as the tenant users, see UserModel and UserModelPostRequest.
+func (m *GridUserResourceModel) toUserModelPostRequest() *UserModelPostRequest {
+	return &UserModelPostRequest{
+		UniqueName: m.UniqueName.ValueString(),
+		FullName:   m.FullName.ValueString(),
+		MemberOf:   toJson(m.MemberOf),
+		Disable:    m.Disable.ValueBool(),
+	}
+}
+
+func (m *GridUserResourceModel) create(client HttpClient) (*GridUserResourceModel, error) {
+	respBody, _, _, err := client.SendRequest("POST", api_grid_users, m.toUserModelPostRequest(), 201)
+	if err != nil {
+		return nil, fmt.Errorf("unable to create grid user: %w", err)
+	}
+
+	return NewGridUserResourceModel(respBody, m, true)
+}
+
+func (m *GridUserResourceModel) read(client HttpClient) (*GridUserResourceModel, error) {
+	endpoint := fmt.Sprintf("%s/%s", api_grid_users, m.ID.ValueString())
+	respBody, _, respCode, err := client.SendRequest("GET", endpoint, nil, 200)
+	if err != nil {
+		if respCode == http.StatusNotFound {
+			return nil, ErrGridUserNotFound
+		}
+		return nil, fmt.Errorf("unable to read grid user: %w", err)
+	}
+
+	return NewGridUserResourceModel(respBody, m, false)
+}
+
+func (m *GridUserResourceModel) update(client HttpClient) (*GridUserResourceModel, error) {
+	endpoint := fmt.Sprintf("%s/%s", api_grid_users, m.ID.ValueString())
+	respBody, _, respCode, err := client.SendRequest("PUT", endpoint, m.toUserModelPostRequest(), 200)
+	if err != nil {
+		if respCode == http.StatusNotFound {
+			return nil, ErrGridUserNotFound
+		}
+		return nil, fmt.Errorf("unable to update grid user: %w", err)
+	}
+
+	return NewGridUserResourceModel(respBody, m, true)
+}
+
+func (m *GridUserResourceModel) delete(client HttpClient) error {
+	endpoint := fmt.Sprintf("%s/%s", api_grid_users, m.ID.ValueString())
+	_, _, respCode, err := client.SendRequest("DELETE", endpoint, nil, 204)
+	if err != nil {
+		if respCode == http.StatusNotFound {
+			return ErrGridUserNotFound
+		}
+		return fmt.Errorf("unable to delete grid user: %w", err)
+	}
+
+	return nil
+}
+
+// NewGridUserResourceModel parses the JSON response from the API into a GridUserResourceModel. The group memberships
+// are reconciled with the ones of the prior model. After a create or update, StorageGRID has to return exactly the
+// planned groups, otherwise a "MemberOf Mismatch" error is returned.
+func NewGridUserResourceModel(input []byte, prior *GridUserResourceModel, exactMemberOf bool) (*GridUserResourceModel, error) {
+	var returnBody UsersDataModelSingle
+	if err := json.Unmarshal(input, &returnBody); err != nil {
+		return nil, &GenericError{Summary: "Client Error", Details: "Unable to parse grid user response, got error: " + err.Error()}
+	}
+
+	user := returnBody.Data
+	if exactMemberOf && !EqualElements(user.MemberOf, toJson(prior.MemberOf)) {
+		return nil, &GenericError{Summary: "MemberOf Mismatch", Details: fmt.Sprintf("Expected %v, got %v", toJson(prior.MemberOf), user.MemberOf)}
+	}
+
+	return &GridUserResourceModel{
+		ID:         types.StringValue(user.ID),
+		UniqueName: types.StringValue(user.UniqueName),
+		FullName:   types.StringValue(user.FullName),
+		MemberOf:   reconcileMemberOf(prior.MemberOf, user.MemberOf),
+		Disable:    types.BoolValue(user.Disable),
+		Federated:  types.BoolValue(user.Federated),
+		UserURN:    types.StringValue(user.UserURN),
+	}, nil
+}
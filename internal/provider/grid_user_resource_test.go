@@ -0,0 +1,74 @@
+// Copyright (c) github.com/dmpe
+// SPDX-License-Identifier: MIT
+
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestGridUserResource(t *testing.T) {
+	gridProvider := gridProviderConfiguration(t)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"storagegrid": providerserver.NewProtocol6WithError(New("test")()),
+		},
+		Steps: []resource.TestStep{
+			// Create
+			{
+				Config: gridUserConfiguration(gridProvider, false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("storagegrid_grid_user.test", "id"),
+					resource.TestCheckResourceAttrSet("storagegrid_grid_user.test", "user_urn"),
+					resource.TestCheckResourceAttr("storagegrid_grid_user.test", "member_of.#", "2"),
+					resource.TestCheckResourceAttrPair("storagegrid_grid_user.test", "member_of.0", "storagegrid_grid_group.test_b", "id"),
+					resource.TestCheckResourceAttr("storagegrid_grid_user.test", "disable", "false"),
+				),
+			},
+			// Update
+			{
+				Config: gridUserConfiguration(gridProvider, true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("storagegrid_grid_user.test", "disable", "true"),
+				),
+			},
+			// Import
+			{
+				ResourceName:      "storagegrid_grid_user.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+				// the order of the groups is not kept by StorageGRID
+				ImportStateVerifyIgnore: []string{"member_of"},
+			},
+			// Delete testing is done automatically
+		},
+	})
+}
+
+func gridUserConfiguration(gridProvider string, disable bool) string {
+	return gridProvider + fmt.Sprintf(`
+resource "storagegrid_grid_group" "test_a" {
+	unique_name  = "group/tf-provider-acc-test-a"
+	display_name = "tf-provider-acc-test-a"
+	permissions  = ["ilm"]
+}
+
+resource "storagegrid_grid_group" "test_b" {
+	unique_name  = "group/tf-provider-acc-test-b"
+	display_name = "tf-provider-acc-test-b"
+	permissions  = ["metrics-query"]
+}
+
+resource "storagegrid_grid_user" "test" {
+	unique_name = "user/tf-provider-acc-test"
+	full_name   = "tf-provider-acc-test"
+	member_of   = [storagegrid_grid_group.test_b.id, storagegrid_grid_group.test_a.id]
+	disable     = %t
+}`, disable)
+}
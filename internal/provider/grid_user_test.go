@@ -0,0 +1,81 @@
+// Copyright (c) github.com/dmpe
+// SPDX-License-Identifier: MIT
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+)
+
+const gridUserResponse = `{"data": {
+	"id": "00000000-0000-0000-0000-000000000001", "accountId": "0", "uniqueName": "user/tf-provider-acc-test",
+	"fullName": "Terraform", "memberOf": ["group-b", "group-a"], "disable": false, "federated": false,
+	"userURN": "urn:sgws:identity::0:user/tf-provider-acc-test"
+}}`
+
+func newTestGridUserResourceModel() GridUserResourceModel {
+	return GridUserResourceModel{
+		ID:         types.StringUnknown(),
+		UniqueName: types.StringValue("user/tf-provider-acc-test"),
+		FullName:   types.StringValue("Terraform"),
+		MemberOf:   []types.String{types.StringValue("group-a"), types.StringValue("group-b")},
+		Disable:    types.BoolValue(false),
+		Federated:  types.BoolUnknown(),
+		UserURN:    types.StringUnknown(),
+	}
+}
+
+func TestGridUserResourceModel_Create(t *testing.T) {
+	model := newTestGridUserResourceModel()
+
+	client := &stubHttpClient{responses: []stubResponse{
+		{body: gridUserResponse, code: 201},
+	}}
+
+	user, err := model.create(client)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"POST /grid/users"}, client.requests)
+	assert.Equal(t, &UserModelPostRequest{
+		UniqueName: "user/tf-provider-acc-test",
+		FullName:   "Terraform",
+		MemberOf:   []string{"group-a", "group-b"},
+	}, client.payloads[0])
+
+	// StorageGRID returns the groups in a different order, the planned order is kept
+	assert.Equal(t, model.MemberOf, user.MemberOf)
+	assert.Equal(t, "00000000-0000-0000-0000-000000000001", user.ID.ValueString())
+	assert.Equal(t, "urn:sgws:identity::0:user/tf-provider-acc-test", user.UserURN.ValueString())
+}
+
+func TestGridUserResourceModel_MemberOfMismatch(t *testing.T) {
+	model := newTestGridUserResourceModel()
+	model.MemberOf = []types.String{types.StringValue("group-a")}
+
+	client := &stubHttpClient{responses: []stubResponse{
+		{body: gridUserResponse, code: 201},
+	}}
+
+	_, err := model.create(client)
+	var genericError *GenericError
+	assert.ErrorAs(t, err, &genericError)
+	assert.Equal(t, "MemberOf Mismatch", genericError.Summary)
+}
+
+func TestGridUserResourceModel_Read(t *testing.T) {
+	model := newTestGridUserResourceModel()
+	model.ID = types.StringValue("00000000-0000-0000-0000-000000000001")
+	model.MemberOf = []types.String{types.StringValue("group-a")}
+
+	client := &stubHttpClient{responses: []stubResponse{
+		{body: gridUserResponse, code: 200},
+	}}
+
+	// a drift of the groups is reported, not rejected
+	user, err := model.read(client)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"GET /grid/users/00000000-0000-0000-0000-000000000001"}, client.requests)
+	assert.Equal(t, []types.String{types.StringValue("group-b"), types.StringValue("group-a")}, user.MemberOf)
+}
@@ -24,6 +24,12 @@ func NewGroupDataSource() datasource.DataSource {
 	return &groupDataSource{}
 }
 
+// groupDataSourceModel extends the group model with the opt-in synchronization of the identity source.
+type groupDataSourceModel struct {
+	GroupsDataSourceModel
+	IdentitySourceSynchronizeModel
+}
+
 // groupDataSource defines the data source implementation.
 type groupDataSource struct {
 	client *S3GridClient
@@ -71,6 +77,14 @@ func (d *groupDataSource) Schema(ctx context.Context, req datasource.SchemaReque
 			"management_read_only": schema.BoolAttribute{
 				Computed: true,
 			},
+			"synchronize_identity_source": schema.BoolAttribute{
+				Optional:    true,
+				Description: "Synchronize the identity source if a federated group is not found by its unique name, e.g. if it has just been added to the LDAP server. The group is fetched until it is found or 'synchronize_timeout' has passed. Defaults to 'false'.",
+			},
+			"synchronize_timeout": schema.StringAttribute{
+				Optional:    true,
+				Description: "How long to wait for the federated group after the synchronization of the identity source, e.g. '30s' or '5m'. Defaults to '2m'.",
+			},
 			"policies": schema.SingleNestedAttribute{
 				Computed: true,
 				Attributes: map[string]schema.Attribute{
@@ -198,11 +212,13 @@ func (d *groupDataSource) Configure(ctx context.Context, req datasource.Configur
 		return
 	}
 
+	resp.Diagnostics.Append(requireTenantScope(client)...)
+
 	d.client = client
 }
 
 func (d *groupDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
-	var state GroupsDataSourceModel
+	var state groupDataSourceModel
 	var jsonData groupsDataSourceGolangModelSingle
 	var s3Sts []*S3PolicyStatementDataModel
 	var idType types.String
@@ -227,7 +243,21 @@ func (d *groupDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 	} else {
 		fullPath = api_groups + "/" + uniqueNameType.ValueString()
 	}
-	rp, _, _, err := d.client.SendRequest("GET", fullPath, nil, 200)
+
+	var rp []byte
+	var err error
+	if state.SynchronizeIdentitySource.ValueBool() && state.ID.IsNull() && isFederated(uniqueNameType.ValueString()) {
+		timeout, timeoutErr := state.timeout()
+		if timeoutErr != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("synchronize_timeout"), "Invalid Synchronize Timeout", timeoutErr.Error())
+			return
+		}
+
+		tflog.Debug(ctx, "Fetching the federated group, synchronizing the identity source if it is not found.")
+		rp, err = readFederatedPrincipal(ctx, d.client, fullPath, timeout)
+	} else {
+		rp, _, _, err = d.client.SendRequest("GET", fullPath, nil, 200)
+	}
 
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read example, got error: %s", err))
@@ -297,7 +327,7 @@ func (d *groupDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 			S3:         s3Policy,
 		},
 	}
-	state = *groupDataSingle
+	state.GroupsDataSourceModel = *groupDataSingle
 
 	resp.Diagnostics.Append(diags...)
 	// Write logs using the tflog package
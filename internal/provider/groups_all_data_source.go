@@ -200,6 +200,8 @@ func (d *groupsDataSource) Configure(ctx context.Context, req datasource.Configu
 		return
 	}
 
+	resp.Diagnostics.Append(requireTenantScope(client)...)
+
 	d.client = client
 }
 
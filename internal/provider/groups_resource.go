@@ -93,7 +93,7 @@ func (r *groupsResource) Schema(ctx context.Context, req resource.SchemaRequest,
 			"unique_name": schema.StringAttribute{
 				Required: true,
 				Description: `The unique name for a group, which cannot be changed. 
-For federated group, the unique name comes from the identity source. The value to specify depends on the type of identity source in use:
+For federated group, the unique name comes from the identity source, see storagegrid_identity_source. The value to specify depends on the type of identity source in use:
 Active Directory: Use the sAMAccountName attribute.
 OpenLDAP: Use the CN (Common Name).
 Other LDAP: Identify the appropriate unique name value for the LDAP server.`,
@@ -254,6 +254,8 @@ func (r *groupsResource) Configure(ctx context.Context, req resource.ConfigureRe
 		return
 	}
 
+	resp.Diagnostics.Append(requireTenantScope(client)...)
+
 	r.client = client
 }
 
@@ -0,0 +1,347 @@
+// Copyright (c) github.com/dmpe
+// SPDX-License-Identifier: MIT
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const (
+	identitySourceActiveDirectory = "active-directory"
+	identitySourceOpenLDAP        = "openldap"
+	identitySourceOther           = "other"
+
+	identitySourceTLSStartTLS = "starttls"
+	identitySourceTLSLDAPS    = "ldaps"
+	identitySourceTLSNone     = "none"
+
+	// the default time the group and user data sources wait for a federated principal after a synchronization
+	identitySourceSynchronizeTimeout = 2 * time.Minute
+)
+
+// identitySourcePollInterval is the time between two lookups of a federated principal. It is shortened in tests.
+var identitySourcePollInterval = 5 * time.Second
+
+// identitySourceServiceTypes maps the type of the resource to the LDAP service type of the API.
+var identitySourceServiceTypes = map[string]string{
+	identitySourceActiveDirectory: "Active Directory",
+	identitySourceOpenLDAP:        "OpenLDAP",
+	identitySourceOther:           "Other",
+}
+
+type IdentitySourceResourceModel struct {
+	ID                 types.String `tfsdk:"id"`
+	Disable            types.Bool   `tfsdk:"disable"`
+	Type               types.String `tfsdk:"type"`
+	Hostname           types.String `tfsdk:"hostname"`
+	Port               types.Int64  `tfsdk:"port"`
+	TLSMode            types.String `tfsdk:"tls_mode"`
+	CaCertificate      types.String `tfsdk:"ca_certificate"`
+	Username           types.String `tfsdk:"username"`
+	Password           types.String `tfsdk:"password_wo"`
+	PasswordVersion    types.Int64  `tfsdk:"password_wo_version"`
+	UserBaseDN         types.String `tfsdk:"user_base_dn"`
+	GroupBaseDN        types.String `tfsdk:"group_base_dn"`
+	BindUsernameFormat types.String `tfsdk:"bind_username_format"`
+	UserIDAttribute    types.String `tfsdk:"user_id_attribute"`
+	UserUUIDAttribute  types.String `tfsdk:"user_uuid_attribute"`
+	GroupIDAttribute   types.String `tfsdk:"group_id_attribute"`
+	GroupUUIDAttribute types.String `tfsdk:"group_uuid_attribute"`
+	ForceSave          types.Bool   `tfsdk:"force_save"`
+	Synchronize        types.Bool   `tfsdk:"synchronize"`
+}
+
+type IdentitySourceApiModel struct {
+	ID                     string `json:"id,omitempty"`
+	Disable                bool   `json:"disable"`
+	Type                   string `json:"type"`
+	LdapServiceType        string `json:"ldapServiceType"`
+	Hostname               string `json:"hostname"`
+	Port                   int64  `json:"port"`
+	Username               string `json:"username"`
+	Password               string `json:"password,omitempty"`
+	BaseUserDn             string `json:"baseUserDn"`
+	BaseGroupDn            string `json:"baseGroupDn"`
+	BindUsernameFormat     string `json:"bindUsernameFormat,omitempty"`
+	LdapUserIdAttribute    string `json:"ldapUserIdAttribute,omitempty"`
+	LdapUserUUIDAttribute  string `json:"ldapUserUUIDAttribute,omitempty"`
+	LdapGroupIdAttribute   string `json:"ldapGroupIdAttribute,omitempty"`
+	LdapGroupUUIDAttribute string `json:"ldapGroupUUIDAttribute,omitempty"`
+	DisableTLS             bool   `json:"disableTLS"`
+	EnableLDAPS            bool   `json:"enableLDAPS"`
+	CaCert                 string `json:"caCert,omitempty"`
+}
+
+func (m *IdentitySourceResourceModel) toIdentitySourceApiModel() *IdentitySourceApiModel {
+	return &IdentitySourceApiModel{
+		ID:                     m.ID.ValueString(),
+		Disable:                m.Disable.ValueBool(),
+		Type:                   "ldap",
+		LdapServiceType:        identitySourceServiceTypes[m.Type.ValueString()],
+		Hostname:               m.Hostname.ValueString(),
+		Port:                   m.Port.ValueInt64(),
+		Username:               m.Username.ValueString(),
+		Password:               m.Password.ValueString(),
+		BaseUserDn:             m.UserBaseDN.ValueString(),
+		BaseGroupDn:            m.GroupBaseDN.ValueString(),
+		BindUsernameFormat:     m.BindUsernameFormat.ValueString(),
+		LdapUserIdAttribute:    m.UserIDAttribute.ValueString(),
+		LdapUserUUIDAttribute:  m.UserUUIDAttribute.ValueString(),
+		LdapGroupIdAttribute:   m.GroupIDAttribute.ValueString(),
+		LdapGroupUUIDAttribute: m.GroupUUIDAttribute.ValueString(),
+		DisableTLS:             m.TLSMode.ValueString() == identitySourceTLSNone,
+		EnableLDAPS:            m.TLSMode.ValueString() == identitySourceTLSLDAPS,
+		CaCert:                 m.CaCertificate.ValueString(),
+	}
+}
+
+// withSecrets copies the write-only password of the configuration into the plan.
+func (m *IdentitySourceResourceModel) withSecrets(config *IdentitySourceResourceModel) {
+	m.Password = config.Password
+}
+
+// test asks StorageGRID to verify that it is able to connect to the identity source, without saving it.
+func (m *IdentitySourceResourceModel) test(client HttpClient) error {
+	_, _, respCode, err := client.SendRequest("PUT", api_identity_source+"?test=true", m.toIdentitySourceApiModel(), 204)
+	if err != nil {
+		if respCode == http.StatusUnprocessableEntity {
+			return &GenericError{Summary: "Identity Source Test Failed", Details: fmt.Sprintf("StorageGRID is unable to use the identity source '%s': %s", m.Hostname.ValueString(), err.Error()), Err: err}
+		}
+		return fmt.Errorf("unable to test identity source: %w", err)
+	}
+
+	return nil
+}
+
+func (m *IdentitySourceResourceModel) upsert(client HttpClient) (*IdentitySourceResourceModel, error) {
+	if !m.ForceSave.ValueBool() {
+		if err := m.test(client); err != nil {
+			return nil, err
+		}
+	}
+
+	respBody, _, _, err := client.SendRequest("PUT", api_identity_source, m.toIdentitySourceApiModel(), 200)
+	if err != nil {
+		return nil, fmt.Errorf("unable to create or update identity source: %w", err)
+	}
+
+	if m.Synchronize.ValueBool() && !m.Disable.ValueBool() {
+		if err := synchronizeIdentitySource(client); err != nil {
+			return nil, err
+		}
+	}
+
+	return NewIdentitySourceResourceModel(respBody, m)
+}
+
+func (m *IdentitySourceResourceModel) read(client HttpClient) (*IdentitySourceResourceModel, error) {
+	respBody, _, _, err := client.SendRequest("GET", api_identity_source, nil, 200)
+	if err != nil {
+		return nil, fmt.Errorf("unable to read identity source: %w", err)
+	}
+
+	return NewIdentitySourceResourceModel(respBody, m)
+}
+
+// delete disables the identity source, as the API does not allow to remove it. Federated users are no longer able to
+// sign in afterwards. The settings of the state are sent without the write-only password, which the state never holds.
+func (m *IdentitySourceResourceModel) delete(client HttpClient) error {
+	payload := m.toIdentitySourceApiModel()
+	payload.Disable = true
+	payload.Password = ""
+
+	_, _, _, err := client.SendRequest("PUT", api_identity_source, payload, 200)
+	if err != nil {
+		return fmt.Errorf("unable to disable identity source: %w", err)
+	}
+
+	return nil
+}
+
+// validate checks the attributes required by the type 'other', and that a CA certificate is only set with TLS.
+func (m *IdentitySourceResourceModel) validate() diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if m.Type.ValueString() == identitySourceOther {
+		attributes := []struct {
+			name  string
+			value types.String
+		}{
+			{"user_id_attribute", m.UserIDAttribute},
+			{"user_uuid_attribute", m.UserUUIDAttribute},
+			{"group_id_attribute", m.GroupIDAttribute},
+			{"group_uuid_attribute", m.GroupUUIDAttribute},
+		}
+
+		for _, attribute := range attributes {
+			if attribute.value.IsNull() {
+				diags.AddAttributeError(
+					path.Root(attribute.name),
+					"Missing LDAP attribute",
+					fmt.Sprintf("The attribute '%s' is required for the type '%s'.", attribute.name, identitySourceOther),
+				)
+			}
+		}
+	}
+
+	if m.TLSMode.ValueString() == identitySourceTLSNone && !m.CaCertificate.IsNull() && !m.CaCertificate.IsUnknown() {
+		diags.AddAttributeError(
+			path.Root("ca_certificate"),
+			"Unexpected CA certificate",
+			fmt.Sprintf("A CA certificate cannot be used with the TLS mode '%s'.", identitySourceTLSNone),
+		)
+	}
+
+	return diags
+}
+
+// synchronizeIdentitySource requests StorageGRID to synchronize the users and groups of the identity source as soon
+// as possible. The synchronization itself runs asynchronously.
+func synchronizeIdentitySource(client HttpClient) error {
+	_, _, _, err := client.SendRequest("POST", api_identity_source+"/synchronize", nil, 204)
+	if err != nil {
+		return fmt.Errorf("unable to synchronize identity source: %w", err)
+	}
+
+	return nil
+}
+
+// NewIdentitySourceResourceModel parses the JSON response from the API into an IdentitySourceResourceModel. As the
+// API never returns the password, the settings which are not known to the API are taken from the given prior model.
+func NewIdentitySourceResourceModel(input []byte, prior *IdentitySourceResourceModel) (*IdentitySourceResourceModel, error) {
+	type responseDataType struct {
+		Data IdentitySourceApiModel `json:"data"`
+	}
+
+	var returnBody responseDataType
+	if err := json.Unmarshal(input, &returnBody); err != nil {
+		return nil, &GenericError{Summary: "Client Error", Details: "Unable to parse identity source response, got error: " + err.Error()}
+	}
+
+	source := returnBody.Data
+
+	model := &IdentitySourceResourceModel{
+		ID:                 types.StringValue(source.ID),
+		Disable:            types.BoolValue(source.Disable),
+		Type:               types.StringNull(),
+		Hostname:           types.StringValue(source.Hostname),
+		Port:               types.Int64Value(source.Port),
+		TLSMode:            types.StringValue(identitySourceTLSStartTLS),
+		CaCertificate:      stringOrNull(source.CaCert),
+		Username:           types.StringValue(source.Username),
+		Password:           types.StringNull(),
+		PasswordVersion:    types.Int64Null(),
+		UserBaseDN:         types.StringValue(source.BaseUserDn),
+		GroupBaseDN:        types.StringValue(source.BaseGroupDn),
+		BindUsernameFormat: stringOrNull(source.BindUsernameFormat),
+		UserIDAttribute:    stringOrNull(source.LdapUserIdAttribute),
+		UserUUIDAttribute:  stringOrNull(source.LdapUserUUIDAttribute),
+		GroupIDAttribute:   stringOrNull(source.LdapGroupIdAttribute),
+		GroupUUIDAttribute: stringOrNull(source.LdapGroupUUIDAttribute),
+		ForceSave:          types.BoolValue(false),
+		Synchronize:        types.BoolValue(true),
+	}
+
+	for k, v := range identitySourceServiceTypes {
+		if v == source.LdapServiceType {
+			model.Type = types.StringValue(k)
+		}
+	}
+
+	switch {
+	case source.DisableTLS:
+		model.TLSMode = types.StringValue(identitySourceTLSNone)
+	case source.EnableLDAPS:
+		model.TLSMode = types.StringValue(identitySourceTLSLDAPS)
+	}
+
+	if prior != nil {
+		model.PasswordVersion = prior.PasswordVersion
+		// force_save and synchronize are not known to the API, an imported identity source uses the defaults
+		if !prior.ForceSave.IsNull() {
+			model.ForceSave = prior.ForceSave
+		}
+		if !prior.Synchronize.IsNull() {
+			model.Synchronize = prior.Synchronize
+		}
+	}
+
+	return model, nil
+}
+
+// IdentitySourceSynchronizeModel holds the opt-in synchronization of the identity source of the group and user data
+// sources, which is used to look up federated principals that have been added to the identity source recently.
+type IdentitySourceSynchronizeModel struct {
+	SynchronizeIdentitySource types.Bool   `tfsdk:"synchronize_identity_source"`
+	SynchronizeTimeout        types.String `tfsdk:"synchronize_timeout"`
+}
+
+// timeout returns the configured synchronization timeout, or the default if it is not set.
+func (m *IdentitySourceSynchronizeModel) timeout() (time.Duration, error) {
+	if m.SynchronizeTimeout.IsNull() || m.SynchronizeTimeout.IsUnknown() {
+		return identitySourceSynchronizeTimeout, nil
+	}
+
+	timeout, err := time.ParseDuration(m.SynchronizeTimeout.ValueString())
+	if err != nil || timeout <= 0 {
+		return 0, fmt.Errorf("'%s' is not a positive duration, e.g. '30s' or '5m'", m.SynchronizeTimeout.ValueString())
+	}
+
+	return timeout, nil
+}
+
+// readFederatedPrincipal reads the given path of a group or user. If StorageGRID does not know it yet, it requests a
+// synchronization of the identity source and polls the path until the principal is found. As the API does not report
+// the progress of the synchronization, it is finished from our point of view as soon as the principal can be read.
+func readFederatedPrincipal(ctx context.Context, client HttpClient, principalPath string, timeout time.Duration) ([]byte, error) {
+	respBody, _, respCode, err := client.SendRequest("GET", principalPath, nil, 200)
+	if err == nil {
+		return respBody, nil
+	}
+	if respCode != http.StatusNotFound {
+		return nil, err
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("Synchronizing the identity source, as %s has not been found.", principalPath))
+	if err := synchronizeIdentitySource(client); err != nil {
+		return nil, err
+	}
+
+	deadline := time.Now().Add(timeout)
+	for {
+		respBody, _, respCode, err = client.SendRequest("GET", principalPath, nil, 200)
+		if err == nil {
+			return respBody, nil
+		}
+		if respCode != http.StatusNotFound {
+			return nil, err
+		}
+
+		if time.Now().Add(identitySourcePollInterval).After(deadline) {
+			return nil, fmt.Errorf("%s has not been found within %s after the synchronization of the identity source: %w", principalPath, timeout, err)
+		}
+
+		tflog.Debug(ctx, fmt.Sprintf("Waiting for the synchronization of the identity source, %s has not been found yet.", principalPath))
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-time.After(identitySourcePollInterval):
+		}
+	}
+}
+
+// isFederated reports whether the unique name refers to a federated group or user, e.g. 'federated-group/admins'.
+func isFederated(uniqueName string) bool {
+	return strings.HasPrefix(uniqueName, "federated-")
+}
@@ -0,0 +1,296 @@
+// Copyright (c) github.com/dmpe
+// SPDX-License-Identifier: MIT
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+// Ensure provider-defined types fully satisfy framework interfaces.
+var (
+	_ resource.Resource                   = &identitySourceResource{}
+	_ resource.ResourceWithConfigure      = &identitySourceResource{}
+	_ resource.ResourceWithImportState    = &identitySourceResource{}
+	_ resource.ResourceWithValidateConfig = &identitySourceResource{}
+)
+
+// NewIdentitySourceResource returns a new resource instance.
+func NewIdentitySourceResource() resource.Resource {
+	return &identitySourceResource{}
+}
+
+type identitySourceResource struct {
+	client *S3GridClient
+}
+
+func (r *identitySourceResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_identity_source"
+}
+
+func (r *identitySourceResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `
+Manage the identity federation of the tenant with an LDAP server, e.g. Active Directory. Federated users and groups, see 'storagegrid_groups', are imported from this identity source.
+
+**Note:**
+- A tenant has at most one identity source, hence this resource should only be declared once per tenant.
+- The identity source cannot be removed through the API. Removing this resource disables it, federated users are no longer able to sign in afterwards.
+- Unless 'force_save' is set, StorageGRID tests the connection to the identity source before it is saved.
+- The password is write-only (Terraform 1.11 or later is required) and never stored in the state. Increase 'password_wo_version' to send a changed password to StorageGRID.
+- The import ID is ignored, as there is only one identity source. As the API never returns the password, an imported identity source is updated once with the configured password.
+`,
+		Attributes: map[string]schema.Attribute{
+			id: schema.StringAttribute{
+				Computed:    true,
+				Description: "The unique identifier of the identity source.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"disable": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Whether the identity source is not used for authentication. Defaults to 'false'.",
+				Default:     booldefault.StaticBool(false),
+			},
+			"type": schema.StringAttribute{
+				Required:    true,
+				Description: "The type of the LDAP service. Can be 'active-directory', 'openldap' or 'other'.",
+				Validators: []validator.String{
+					stringvalidator.OneOf(identitySourceActiveDirectory, identitySourceOpenLDAP, identitySourceOther),
+				},
+			},
+			"hostname": schema.StringAttribute{
+				Required:    true,
+				Description: "The hostname or IP address of the LDAP server.",
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"port": schema.Int64Attribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "The port of the LDAP server. Defaults to '389', LDAPS usually uses '636'.",
+				Default:     int64default.StaticInt64(389),
+				Validators: []validator.Int64{
+					int64validator.Between(1, 65535),
+				},
+			},
+			"tls_mode": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "How the connection to the LDAP server is secured. Can be 'starttls', 'ldaps' or 'none'. Defaults to 'starttls'.",
+				Default:     stringdefault.StaticString(identitySourceTLSStartTLS),
+				Validators: []validator.String{
+					stringvalidator.OneOf(identitySourceTLSStartTLS, identitySourceTLSLDAPS, identitySourceTLSNone),
+				},
+			},
+			"ca_certificate": schema.StringAttribute{
+				Optional:    true,
+				Description: "A custom CA certificate (in PEM encoding) used to verify the LDAP server. The CA certificates of the operating system are used if omitted.",
+			},
+			"username": schema.StringAttribute{
+				Required:    true,
+				Description: "The username used to bind to the LDAP server, e.g. 'MYDOMAIN\\Administrator'.",
+			},
+			"password_wo": schema.StringAttribute{
+				Required:    true,
+				Sensitive:   true,
+				WriteOnly:   true,
+				Description: "The password used to bind to the LDAP server.",
+			},
+			"password_wo_version": schema.Int64Attribute{
+				Optional:    true,
+				Description: "An arbitrary version of the write-only password. Change it to send a changed password to StorageGRID.",
+			},
+			"user_base_dn": schema.StringAttribute{
+				Required:    true,
+				Description: "The distinguished name of the LDAP subtree which is searched for users, e.g. 'OU=Users,DC=example,DC=com'.",
+			},
+			"group_base_dn": schema.StringAttribute{
+				Required:    true,
+				Description: "The distinguished name of the LDAP subtree which is searched for groups, e.g. 'OU=Groups,DC=example,DC=com'.",
+			},
+			"bind_username_format": schema.StringAttribute{
+				Optional:    true,
+				Description: "The username pattern used to bind users if it cannot be determined automatically, e.g. '[USERNAME]@example.com'.",
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 256),
+				},
+			},
+			"user_id_attribute": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "The LDAP attribute with the unique name of a user, e.g. 'sAMAccountName'. Required for the type 'other'.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"user_uuid_attribute": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "The LDAP attribute with the permanent unique identifier of a user, e.g. 'objectGUID'. Required for the type 'other'.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"group_id_attribute": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "The LDAP attribute with the unique name of a group, e.g. 'sAMAccountName'. Required for the type 'other'.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"group_uuid_attribute": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "The LDAP attribute with the permanent unique identifier of a group, e.g. 'objectGUID'. Required for the type 'other'.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"force_save": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Save the identity source without testing whether StorageGRID is able to connect to it. Defaults to 'false'.",
+				Default:     booldefault.StaticBool(false),
+			},
+			"synchronize": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Request a synchronization of the users and groups after every change of the identity source. Defaults to 'true'.",
+				Default:     booldefault.StaticBool(true),
+			},
+		},
+	}
+}
+
+func (r *identitySourceResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*S3GridClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	resp.Diagnostics.Append(requireTenantScope(client)...)
+
+	r.client = client
+}
+
+func (r *identitySourceResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config IdentitySourceResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(config.validate()...)
+}
+
+func (r *identitySourceResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan, config IdentitySourceResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan.withSecrets(&config)
+
+	source, err := plan.upsert(r.client)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, source)...)
+}
+
+func (r *identitySourceResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state IdentitySourceResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	read, err := state.read(r.client)
+	if err != nil {
+		resp.Diagnostics.AddError("Error Reading StorageGrid identity source", err.Error())
+		return
+	}
+
+	// the identity source has never been configured
+	if read.Hostname.ValueString() == "" {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, read)...)
+}
+
+func (r *identitySourceResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, config IdentitySourceResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan.withSecrets(&config)
+
+	source, err := plan.upsert(r.client)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, source)...)
+}
+
+func (r *identitySourceResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state IdentitySourceResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := state.delete(r.client)
+	if err != nil {
+		resp.Diagnostics.AddError("Error Disabling StorageGrid identity source", err.Error())
+		return
+	}
+}
+
+func (r *identitySourceResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root(id), req, resp)
+}
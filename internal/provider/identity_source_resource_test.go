@@ -0,0 +1,42 @@
+// Copyright (c) github.com/dmpe
+// SPDX-License-Identifier: MIT
+
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+// The tenant used for acceptance tests must not be federated, hence only the validation is tested here.
+func TestIdentitySourceResource_InvalidConfiguration(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"storagegrid": providerserver.NewProtocol6WithError(New("test")()),
+		},
+		// write-only attributes require Terraform 1.11
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_11_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: `
+resource "storagegrid_identity_source" "test" {
+  type          = "other"
+  hostname      = "ldap.example.com"
+  username      = "cn=admin,dc=example,dc=com"
+  password_wo   = "secret"
+  user_base_dn  = "ou=users,dc=example,dc=com"
+  group_base_dn = "ou=groups,dc=example,dc=com"
+}
+`,
+				ExpectError: regexp.MustCompile("Missing LDAP attribute"),
+			},
+		},
+	})
+}
@@ -0,0 +1,262 @@
+// Copyright (c) github.com/dmpe
+// SPDX-License-Identifier: MIT
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+)
+
+const identitySourceResponse = `{"data": {
+	"id": "c8a3", "disable": false, "type": "ldap", "ldapServiceType": "Active Directory",
+	"hostname": "ldap.example.com", "port": 636, "username": "MYDOMAIN\\Administrator",
+	"baseUserDn": "OU=Users,DC=example,DC=com", "baseGroupDn": "OU=Groups,DC=example,DC=com",
+	"ldapUserIdAttribute": "sAMAccountName", "ldapUserUUIDAttribute": "objectGUID",
+	"ldapGroupIdAttribute": "sAMAccountName", "ldapGroupUUIDAttribute": "objectGUID",
+	"disableTLS": false, "enableLDAPS": true
+}}`
+
+func newTestIdentitySourceResourceModel(sourceType string) IdentitySourceResourceModel {
+	return IdentitySourceResourceModel{
+		ID:                 types.StringUnknown(),
+		Disable:            types.BoolValue(false),
+		Type:               types.StringValue(sourceType),
+		Hostname:           types.StringValue("ldap.example.com"),
+		Port:               types.Int64Value(636),
+		TLSMode:            types.StringValue(identitySourceTLSLDAPS),
+		CaCertificate:      types.StringNull(),
+		Username:           types.StringValue(`MYDOMAIN\Administrator`),
+		Password:           types.StringValue("secret"),
+		PasswordVersion:    types.Int64Value(1),
+		UserBaseDN:         types.StringValue("OU=Users,DC=example,DC=com"),
+		GroupBaseDN:        types.StringValue("OU=Groups,DC=example,DC=com"),
+		BindUsernameFormat: types.StringNull(),
+		UserIDAttribute:    types.StringUnknown(),
+		UserUUIDAttribute:  types.StringUnknown(),
+		GroupIDAttribute:   types.StringUnknown(),
+		GroupUUIDAttribute: types.StringUnknown(),
+		ForceSave:          types.BoolValue(false),
+		Synchronize:        types.BoolValue(true),
+	}
+}
+
+func TestIdentitySourceResourceModel_Validate(t *testing.T) {
+	other := newTestIdentitySourceResourceModel(identitySourceOther)
+	other.UserIDAttribute = types.StringValue("uid")
+	other.UserUUIDAttribute = types.StringValue("entryUUID")
+	other.GroupIDAttribute = types.StringValue("cn")
+	other.GroupUUIDAttribute = types.StringValue("entryUUID")
+
+	otherMissingAttribute := other
+	otherMissingAttribute.GroupUUIDAttribute = types.StringNull()
+
+	noTLSWithCertificate := newTestIdentitySourceResourceModel(identitySourceOpenLDAP)
+	noTLSWithCertificate.TLSMode = types.StringValue(identitySourceTLSNone)
+	noTLSWithCertificate.CaCertificate = types.StringValue("-----BEGIN CERTIFICATE-----")
+
+	tests := []struct {
+		name   string
+		model  IdentitySourceResourceModel
+		errors []string
+	}{
+		{"active directory", newTestIdentitySourceResourceModel(identitySourceActiveDirectory), nil},
+		{"other with attributes", other, nil},
+		{"other without group UUID attribute", otherMissingAttribute, []string{"Missing LDAP attribute"}},
+		{"no TLS with CA certificate", noTLSWithCertificate, []string{"Unexpected CA certificate"}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			diags := test.model.validate()
+
+			var summaries []string
+			for _, d := range diags.Errors() {
+				summaries = append(summaries, d.Summary())
+			}
+			assert.Equal(t, test.errors, summaries)
+		})
+	}
+}
+
+func TestIdentitySourceResourceModel_Upsert(t *testing.T) {
+	model := newTestIdentitySourceResourceModel(identitySourceActiveDirectory)
+
+	client := &stubHttpClient{responses: []stubResponse{
+		{code: 204},
+		{body: identitySourceResponse, code: 200},
+		{code: 204},
+	}}
+
+	created, err := model.upsert(client)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{
+		"PUT /org/identity-source?test=true",
+		"PUT /org/identity-source",
+		"POST /org/identity-source/synchronize",
+	}, client.requests)
+
+	payload := client.payloads[1].(*IdentitySourceApiModel)
+	assert.Equal(t, "Active Directory", payload.LdapServiceType)
+	assert.Equal(t, "ldap", payload.Type)
+	assert.Equal(t, "secret", payload.Password)
+	assert.True(t, payload.EnableLDAPS)
+	assert.False(t, payload.DisableTLS)
+	assert.Empty(t, payload.LdapUserIdAttribute)
+
+	// the password must not end up in the state
+	assert.Equal(t, types.StringValue("c8a3"), created.ID)
+	assert.Equal(t, types.StringValue(identitySourceActiveDirectory), created.Type)
+	assert.Equal(t, types.StringValue(identitySourceTLSLDAPS), created.TLSMode)
+	assert.Equal(t, types.StringValue("sAMAccountName"), created.UserIDAttribute)
+	assert.Equal(t, types.StringNull(), created.Password)
+	assert.Equal(t, types.Int64Value(1), created.PasswordVersion)
+}
+
+func TestIdentitySourceResourceModel_UpsertWithoutTestAndSynchronization(t *testing.T) {
+	model := newTestIdentitySourceResourceModel(identitySourceActiveDirectory)
+	model.ForceSave = types.BoolValue(true)
+	model.Synchronize = types.BoolValue(false)
+
+	client := &stubHttpClient{responses: []stubResponse{
+		{body: identitySourceResponse, code: 200},
+	}}
+
+	updated, err := model.upsert(client)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"PUT /org/identity-source"}, client.requests)
+	assert.Equal(t, types.BoolValue(true), updated.ForceSave)
+	assert.Equal(t, types.BoolValue(false), updated.Synchronize)
+}
+
+func TestIdentitySourceResourceModel_UpsertTestFailed(t *testing.T) {
+	model := newTestIdentitySourceResourceModel(identitySourceActiveDirectory)
+
+	client := &stubHttpClient{responses: []stubResponse{
+		{code: 422, err: fmt.Errorf("invalid credentials")},
+	}}
+
+	_, err := model.upsert(client)
+	assert.ErrorIs(t, err, &GenericError{Summary: "Identity Source Test Failed"})
+	assert.Len(t, client.requests, 1)
+}
+
+func TestIdentitySourceResourceModel_Delete(t *testing.T) {
+	// the state never holds the write-only password
+	model := newTestIdentitySourceResourceModel(identitySourceActiveDirectory)
+	model.ID = types.StringValue("c8a3")
+	model.Password = types.StringNull()
+
+	client := &stubHttpClient{responses: []stubResponse{
+		{body: identitySourceResponse, code: 200},
+	}}
+
+	assert.NoError(t, model.delete(client))
+	assert.Equal(t, []string{"PUT /org/identity-source"}, client.requests)
+
+	// the settings of the state are kept, only the password is left out
+	payload := client.payloads[0].(*IdentitySourceApiModel)
+	assert.True(t, payload.Disable)
+	assert.Equal(t, "c8a3", payload.ID)
+	assert.Equal(t, "Active Directory", payload.LdapServiceType)
+	assert.Equal(t, model.Hostname.ValueString(), payload.Hostname)
+	assert.Equal(t, model.UserBaseDN.ValueString(), payload.BaseUserDn)
+	assert.Empty(t, payload.Password)
+
+	body, err := json.Marshal(payload)
+	assert.NoError(t, err)
+	assert.NotContains(t, string(body), `"password"`)
+}
+
+func TestSynchronizeIdentitySource(t *testing.T) {
+	client := &stubHttpClient{responses: []stubResponse{
+		{code: 500, err: fmt.Errorf("unexpected status code")},
+	}}
+
+	err := synchronizeIdentitySource(client)
+	assert.ErrorContains(t, err, "unable to synchronize identity source")
+	assert.Equal(t, []string{"POST /org/identity-source/synchronize"}, client.requests)
+}
+
+func TestReadFederatedPrincipal(t *testing.T) {
+	identitySourcePollInterval = time.Millisecond
+	t.Cleanup(func() { identitySourcePollInterval = 5 * time.Second })
+
+	client := &stubHttpClient{responses: []stubResponse{
+		{code: 404, err: fmt.Errorf("unexpected status code")},
+		{code: 204},
+		{code: 404, err: fmt.Errorf("unexpected status code")},
+		{body: `{"data": {"uniqueName": "federated-group/admins"}}`, code: 200},
+	}}
+
+	body, err := readFederatedPrincipal(context.Background(), client, "/org/groups/federated-group/admins", time.Minute)
+	assert.NoError(t, err)
+	assert.Contains(t, string(body), "federated-group/admins")
+	assert.Equal(t, []string{
+		"GET /org/groups/federated-group/admins",
+		"POST /org/identity-source/synchronize",
+		"GET /org/groups/federated-group/admins",
+		"GET /org/groups/federated-group/admins",
+	}, client.requests)
+}
+
+func TestReadFederatedPrincipal_Known(t *testing.T) {
+	// a known principal is read without a synchronization of the identity source
+	client := &stubHttpClient{responses: []stubResponse{
+		{body: `{"data": {"uniqueName": "federated-group/admins"}}`, code: 200},
+	}}
+
+	body, err := readFederatedPrincipal(context.Background(), client, "/org/groups/federated-group/admins", time.Minute)
+	assert.NoError(t, err)
+	assert.Contains(t, string(body), "federated-group/admins")
+	assert.Equal(t, []string{"GET /org/groups/federated-group/admins"}, client.requests)
+}
+
+func TestReadFederatedPrincipal_Timeout(t *testing.T) {
+	identitySourcePollInterval = time.Millisecond
+	t.Cleanup(func() { identitySourcePollInterval = 5 * time.Second })
+
+	client := &stubHttpClient{responses: []stubResponse{
+		{code: 404, err: fmt.Errorf("unexpected status code")},
+		{code: 204},
+		{code: 404, err: fmt.Errorf("unexpected status code")},
+	}}
+
+	_, err := readFederatedPrincipal(context.Background(), client, "/org/users/federated-user/jdoe", time.Nanosecond)
+	assert.ErrorContains(t, err, "has not been found within 1ns")
+	assert.Len(t, client.requests, 3)
+}
+
+func TestReadFederatedPrincipal_Error(t *testing.T) {
+	client := &stubHttpClient{responses: []stubResponse{
+		{code: 403, err: fmt.Errorf("unexpected status code")},
+	}}
+
+	_, err := readFederatedPrincipal(context.Background(), client, "/org/users/federated-user/jdoe", time.Minute)
+	assert.ErrorContains(t, err, "unexpected status code")
+	assert.Len(t, client.requests, 1)
+}
+
+func TestIdentitySourceSynchronizeModel_Timeout(t *testing.T) {
+	model := IdentitySourceSynchronizeModel{SynchronizeTimeout: types.StringNull()}
+	timeout, err := model.timeout()
+	assert.NoError(t, err)
+	assert.Equal(t, identitySourceSynchronizeTimeout, timeout)
+
+	model.SynchronizeTimeout = types.StringValue("30s")
+	timeout, err = model.timeout()
+	assert.NoError(t, err)
+	assert.Equal(t, 30*time.Second, timeout)
+
+	for _, invalid := range []string{"thirty seconds", "0s", "-1m"} {
+		model.SynchronizeTimeout = types.StringValue(invalid)
+		_, err = model.timeout()
+		assert.Error(t, err, invalid)
+	}
+}
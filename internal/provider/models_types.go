@@ -7,6 +7,8 @@ import (
 	"encoding/json"
 	"fmt"
 	"net/http"
+	"sync"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
@@ -20,18 +22,63 @@ type S3GridClient struct {
 	password   string
 	token      string
 	tenant     string
+	gridAdmin  bool
 	insecure   bool
 	httpClient *http.Client
+
+	// samlResponse returns the SAML response of the identity provider, if the client authenticates with single sign-on
+	samlResponse SamlResponseFunc
+
+	// tokenFile is read again when StorageGRID rejects the token
+	tokenFile string
+
+	// maxRetries is the number of times a request is repeated after a transient failure, with a backoff between
+	// retryMinWait and retryMaxWait
+	maxRetries   int
+	retryMinWait time.Duration
+	retryMaxWait time.Duration
+
+	// tokenMu guards the token and its expiry, which are renewed by concurrent requests
+	tokenMu      sync.Mutex
+	tokenExpires time.Time
 }
 
+// S3GridClientJson is the payload of the authorize request. A grid administrator authenticates without an account ID.
 type S3GridClientJson struct {
-	AccountId string `json:"accountId"`
+	AccountId string `json:"accountId,omitempty"`
 	Username  string `json:"username"`
 	Password  string `json:"password"`
 	Cookie    bool   `json:"cookie"`
 	CsrfToken bool   `json:"csrfToken"`
 }
 
+// S3GridClientSamlJson is the payload of the authorize-saml request. A grid administrator uses the account ID "0".
+type S3GridClientSamlJson struct {
+	AccountId string `json:"accountId"`
+}
+
+// S3GridClientConfigJson is the part of the account configuration with the expiry of the current token.
+type S3GridClientConfigJson struct {
+	Data struct {
+		Token struct {
+			Expires time.Time `json:"expires"`
+		} `json:"token"`
+	} `json:"data"`
+}
+
+// S3GridClientErrorJson is the error response of StorageGRID, with the errors of single fields of the request.
+type S3GridClientErrorJson struct {
+	Code    int                              `json:"code"`
+	Message S3GridClientLocalizedErrorJson   `json:"message"`
+	Errors  []S3GridClientLocalizedErrorJson `json:"errors"`
+}
+
+type S3GridClientLocalizedErrorJson struct {
+	Text    string `json:"text"`
+	Key     string `json:"key"`
+	Context string `json:"context"`
+}
+
 type S3GridClientReturnJson struct {
 	Data string `json:"data"`
 }
@@ -401,6 +448,8 @@ type BucketResourceModel struct {
 	Name                    types.String             `tfsdk:"name"`
 	Region                  types.String             `tfsdk:"region"`
 	ObjectLockConfiguration *ObjectLockConfiguration `tfsdk:"object_lock_configuration"`
+	LastAccessTimeEnabled   types.Bool               `tfsdk:"last_access_time_enabled"`
+	ConsistencyLevel        types.String             `tfsdk:"consistency_level"`
 }
 
 func (m *BucketResourceModel) ToBucketModel() BucketApiRequestModel {
@@ -451,3 +500,33 @@ type RetentionSettings struct {
 type BucketApiResponseModel struct {
 	Data BucketApiRequestModel `json:"data"`
 }
+
+// BucketComplianceResourceModel is the representation of the legacy Compliance settings of a bucket in the state.
+type BucketComplianceResourceModel struct {
+	BucketName             types.String `tfsdk:"bucket_name"`
+	AutoDelete             types.Bool   `tfsdk:"auto_delete"`
+	LegalHold              types.Bool   `tfsdk:"legal_hold"`
+	RetentionPeriodMinutes types.Int64  `tfsdk:"retention_period_minutes"`
+}
+
+func (m *BucketComplianceResourceModel) ToBucketComplianceModel() BucketComplianceApiModel {
+	return BucketComplianceApiModel{
+		AutoDelete:             m.AutoDelete.ValueBool(),
+		LegalHold:              m.LegalHold.ValueBool(),
+		RetentionPeriodMinutes: m.RetentionPeriodMinutes.ValueInt64(),
+	}
+}
+
+// BucketComplianceApiModel is the representation of the legacy Compliance settings of a bucket in the API.
+type BucketComplianceApiModel struct {
+	AutoDelete             bool  `json:"autoDelete"`
+	LegalHold              bool  `json:"legalHold"`
+	RetentionPeriodMinutes int64 `json:"retentionPeriodMinutes"`
+}
+
+// ComplianceGlobalApiModel is the representation of the grid's global S3 Object Lock settings in the API.
+type ComplianceGlobalApiModel struct {
+	ComplianceEnabled             bool `json:"complianceEnabled"`
+	LegacyComplianceEnabled       bool `json:"legacyComplianceEnabled"`
+	CreateLegacyComplianceBuckets bool `json:"createLegacyComplianceBuckets"`
+}
@@ -5,7 +5,12 @@ package provider
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"os"
+	"strconv"
+	"strings"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/function"
@@ -33,12 +38,21 @@ type storagegridProvider struct {
 
 // storagegridProviderModel describes the provider data model.
 type storagegridProviderModel struct {
-	Address            types.String `tfsdk:"address"`
-	Username           types.String `tfsdk:"username"`
-	Password           types.String `tfsdk:"password"`
-	Tenant             types.String `tfsdk:"tenant"`
-	EnableTraceContext types.Bool   `tfsdk:"enable_trace_context"`
-	Insecure           types.Bool   `tfsdk:"insecure"`
+	Address                 types.String `tfsdk:"address"`
+	Username                types.String `tfsdk:"username"`
+	Password                types.String `tfsdk:"password"`
+	Tenant                  types.String `tfsdk:"tenant"`
+	GridAdmin               types.Bool   `tfsdk:"grid_admin"`
+	Token                   types.String `tfsdk:"token"`
+	TokenFile               types.String `tfsdk:"token_file"`
+	Saml                    types.Bool   `tfsdk:"saml"`
+	SamlCommand             types.List   `tfsdk:"saml_response_command"`
+	EnableTraceContext      types.Bool   `tfsdk:"enable_trace_context"`
+	Insecure                types.Bool   `tfsdk:"insecure"`
+	SkipAuthenticationCheck types.Bool   `tfsdk:"skip_authentication_check"`
+	MaxRetries              types.Int64  `tfsdk:"max_retries"`
+	RetryMinWait            types.Int64  `tfsdk:"retry_min_wait"`
+	RetryMaxWait            types.Int64  `tfsdk:"retry_max_wait"`
 }
 
 func (p *storagegridProvider) Metadata(ctx context.Context, req provider.MetadataRequest, resp *provider.MetadataResponse) {
@@ -66,10 +80,41 @@ func (p *storagegridProvider) Schema(ctx context.Context, req provider.SchemaReq
 				Sensitive:   true,
 			},
 			"tenant": schema.StringAttribute{
-				Description: "Provide tenant ID.",
+				Description: "Provide tenant ID. Required unless `grid_admin`, `token` or `token_file` is set.",
 				Optional:    true,
 				Sensitive:   false,
 			},
+			"grid_admin": schema.BoolAttribute{
+				Optional: true,
+				MarkdownDescription: "Authenticate as grid administrator for the Grid Management API, which is required by the `storagegrid_grid_*` resources. " +
+					"The tenant must not be set then, all other resources require a provider with `tenant`. Use a provider alias to manage both. " +
+					"Can also be set with the `STORAGEGRID_GRID_ADMIN` environment variable. Default: `false`",
+			},
+			"token": schema.StringAttribute{
+				Optional:  true,
+				Sensitive: true,
+				MarkdownDescription: "A pre-issued bearer token, used instead of `username` and `password`. The token must belong to the `tenant`, or to a grid administrator with `grid_admin`. " +
+					"Can also be set with the `STORAGEGRID_TOKEN` environment variable.",
+			},
+			"token_file": schema.StringAttribute{
+				Optional: true,
+				MarkdownDescription: "The path of a file with a pre-issued bearer token, e.g. written by a Vault agent. The file is read again when StorageGRID rejects the token, to pick up a renewed token. " +
+					"Can also be set with the `STORAGEGRID_TOKEN_FILE` environment variable.",
+			},
+			"saml": schema.BoolAttribute{
+				Optional: true,
+				MarkdownDescription: "Authenticate with single sign-on (SAML) instead of `username` and `password`. " +
+					"The SAML response of the identity provider is taken from the `STORAGEGRID_SAML_RESPONSE` environment variable, or printed by the `saml_response_command`. " +
+					"A SAML response of `STORAGEGRID_SAML_RESPONSE` is used once and cannot renew an expired token; set the `saml_response_command` if a plan or apply outlasts the token. " +
+					"Can also be set with the `STORAGEGRID_SAML` environment variable. Default: `false`",
+			},
+			"saml_response_command": schema.ListAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+				MarkdownDescription: "The helper command, with its arguments, which prints the base64 encoded SAML response of the identity provider to stdout. " +
+					"It gets the SAML request URL of the identity provider in the `STORAGEGRID_SAML_REQUEST_URL` environment variable. " +
+					"Can also be set with the `STORAGEGRID_SAML_RESPONSE_COMMAND` environment variable, with the arguments separated by spaces. Takes precedence over `STORAGEGRID_SAML_RESPONSE`.",
+			},
 			"enable_trace_context": schema.BoolAttribute{
 				Optional:            true,
 				MarkdownDescription: "Enable trace context. If `true` a `Traceparent` header will be added to the request. Default: `false`",
@@ -78,6 +123,28 @@ func (p *storagegridProvider) Schema(ctx context.Context, req provider.SchemaReq
 				Optional:            true,
 				MarkdownDescription: "Use insecure HTTP connection. Setting this to `true` will ignore certificates when calling REST API. Default: `false`",
 			},
+			"skip_authentication_check": schema.BoolAttribute{
+				Optional: true,
+				MarkdownDescription: "Do not authenticate when the provider is configured, e.g. for offline runs without access to StorageGRID. " +
+					"The provider then authenticates before its first request, and authentication failures are only reported by the resources. " +
+					"Can also be set with the `STORAGEGRID_SKIP_AUTHENTICATION_CHECK` environment variable. Default: `false`",
+			},
+			"max_retries": schema.Int64Attribute{
+				Optional: true,
+				MarkdownDescription: "The number of times a request is repeated after a transient failure, e.g. while StorageGRID is upgraded. " +
+					"Requests answered with 429 or 503 are repeated for every method, connection errors and other server errors only for idempotent methods. " +
+					"Set to `0` to disable the retries. Can also be set with the `STORAGEGRID_MAX_RETRIES` environment variable. Default: `3`",
+			},
+			"retry_min_wait": schema.Int64Attribute{
+				Optional: true,
+				MarkdownDescription: "The wait in seconds before the first retry, which doubles with every further retry. Up to half of the wait is left out at random. " +
+					"The `Retry-After` of StorageGRID takes precedence. Can also be set with the `STORAGEGRID_RETRY_MIN_WAIT` environment variable. Default: `1`",
+			},
+			"retry_max_wait": schema.Int64Attribute{
+				Optional: true,
+				MarkdownDescription: "The maximum wait in seconds before a retry, also for the `Retry-After` of StorageGRID. " +
+					"Can also be set with the `STORAGEGRID_RETRY_MAX_WAIT` environment variable. Default: `30`",
+			},
 		},
 	}
 }
@@ -85,6 +152,12 @@ func (p *storagegridProvider) Schema(ctx context.Context, req provider.SchemaReq
 func (p *storagegridProvider) Configure(ctx context.Context, req provider.ConfigureRequest, resp *provider.ConfigureResponse) {
 	var data storagegridProviderModel
 	var insecure bool
+	var gridAdmin bool
+	var saml bool
+	var skipAuthenticationCheck bool
+	maxRetries := int64(defaultMaxRetries)
+	retryMinWait := int64(defaultRetryMinWait / time.Second)
+	retryMaxWait := int64(defaultRetryMaxWait / time.Second)
 	tflog.Debug(ctx, "Configuring StorageGrid client.")
 
 	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
@@ -135,6 +208,34 @@ func (p *storagegridProvider) Configure(ctx context.Context, req provider.Config
 	password := os.Getenv("STORAGEGRID_PASSWORD")
 	tenant := os.Getenv("STORAGEGRID_TENANT")
 	trc_ctxt := os.Getenv("TF_ACC")
+	token := os.Getenv("STORAGEGRID_TOKEN")
+	tokenFile := os.Getenv("STORAGEGRID_TOKEN_FILE")
+	samlResponse := os.Getenv("STORAGEGRID_SAML_RESPONSE")
+	samlCommand := strings.Fields(os.Getenv("STORAGEGRID_SAML_RESPONSE_COMMAND"))
+
+	if v, err := strconv.ParseBool(os.Getenv("STORAGEGRID_GRID_ADMIN")); err == nil {
+		gridAdmin = v
+	}
+
+	if v, err := strconv.ParseBool(os.Getenv("STORAGEGRID_SAML")); err == nil {
+		saml = v
+	}
+
+	if v, err := strconv.ParseBool(os.Getenv("STORAGEGRID_SKIP_AUTHENTICATION_CHECK")); err == nil {
+		skipAuthenticationCheck = v
+	}
+
+	if v, err := strconv.ParseInt(os.Getenv("STORAGEGRID_MAX_RETRIES"), 10, 64); err == nil {
+		maxRetries = v
+	}
+
+	if v, err := strconv.ParseInt(os.Getenv("STORAGEGRID_RETRY_MIN_WAIT"), 10, 64); err == nil {
+		retryMinWait = v
+	}
+
+	if v, err := strconv.ParseInt(os.Getenv("STORAGEGRID_RETRY_MAX_WAIT"), 10, 64); err == nil {
+		retryMaxWait = v
+	}
 
 	if !data.Address.IsNull() {
 		address = data.Address.ValueString()
@@ -152,10 +253,45 @@ func (p *storagegridProvider) Configure(ctx context.Context, req provider.Config
 		tenant = data.Tenant.ValueString()
 	}
 
+	if !data.GridAdmin.IsNull() {
+		gridAdmin = data.GridAdmin.ValueBool()
+	}
+
+	// a token of the configuration replaces both tokens of the environment
+	if !data.Token.IsNull() || !data.TokenFile.IsNull() {
+		token = data.Token.ValueString()
+		tokenFile = data.TokenFile.ValueString()
+	}
+
+	if !data.Saml.IsNull() {
+		saml = data.Saml.ValueBool()
+	}
+
+	if !data.SamlCommand.IsNull() && !data.SamlCommand.IsUnknown() {
+		samlCommand = nil
+		resp.Diagnostics.Append(data.SamlCommand.ElementsAs(ctx, &samlCommand, false)...)
+	}
+
 	if !data.Insecure.IsNull() {
 		insecure = data.Insecure.ValueBool()
 	}
 
+	if !data.SkipAuthenticationCheck.IsNull() {
+		skipAuthenticationCheck = data.SkipAuthenticationCheck.ValueBool()
+	}
+
+	if !data.MaxRetries.IsNull() {
+		maxRetries = data.MaxRetries.ValueInt64()
+	}
+
+	if !data.RetryMinWait.IsNull() {
+		retryMinWait = data.RetryMinWait.ValueInt64()
+	}
+
+	if !data.RetryMaxWait.IsNull() {
+		retryMaxWait = data.RetryMaxWait.ValueInt64()
+	}
+
 	if trc_ctxt == "1" {
 		data.EnableTraceContext = types.BoolValue(true)
 	}
@@ -174,33 +310,118 @@ func (p *storagegridProvider) Configure(ctx context.Context, req provider.Config
 		)
 	}
 
-	if username == "" {
+	if maxRetries < 0 {
 		resp.Diagnostics.AddAttributeError(
-			path.Root("username"),
-			"Missing StorageGrid username",
-			"The provider cannot create the StorageGrid API client as there is a missing or empty value for the StorageGrid API username. "+
-				"Set the username value in the configuration or use the STORAGEGRID_USERNAME environment variable. "+
-				"If either is already set, ensure the value is not empty.",
+			path.Root("max_retries"),
+			"Invalid StorageGrid retries",
+			"The provider cannot repeat a request a negative number of times. "+
+				"Set the max_retries value in the configuration or the STORAGEGRID_MAX_RETRIES environment variable to 0 or more.",
 		)
 	}
 
-	if password == "" {
+	if retryMinWait < 0 || retryMaxWait < retryMinWait {
 		resp.Diagnostics.AddAttributeError(
-			path.Root("password"),
-			"Missing StorageGrid password",
-			"The provider cannot create the StorageGrid API client as there is a missing or empty value for the StorageGrid API password. "+
-				"Set the password value in the configuration or use the STORAGEGRID_PASSWORD environment variable. "+
-				"If either is already set, ensure the value is not empty.",
+			path.Root("retry_max_wait"),
+			"Invalid StorageGrid retry wait",
+			fmt.Sprintf("The provider cannot wait between %d and %d seconds before a retry. "+
+				"The retry_min_wait value must not be negative, and the retry_max_wait value must not be less than the retry_min_wait value.", retryMinWait, retryMaxWait),
+		)
+	}
+
+	tokenAuth := token != "" || tokenFile != ""
+
+	if token != "" && tokenFile != "" {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("token_file"),
+			"Conflicting StorageGrid token",
+			"The provider cannot use a token and a token file at the same time. "+
+				"Remove either the token or the token_file value from the configuration, or unset the STORAGEGRID_TOKEN or STORAGEGRID_TOKEN_FILE environment variable.",
+		)
+	}
+
+	if tokenAuth && saml {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("saml"),
+			"Conflicting StorageGrid authentication",
+			"The provider cannot authenticate with a bearer token and with single sign-on (SAML) at the same time. "+
+				"Remove the token or unset saml.",
 		)
 	}
 
-	if tenant == "" {
+	if tokenAuth || saml {
+		method, setting := "a bearer token", "the token"
+		if !tokenAuth {
+			method, setting = "single sign-on (SAML)", "saml"
+		}
+
+		for _, credential := range []struct {
+			name  string
+			value types.String
+		}{
+			{"username", data.Username},
+			{"password", data.Password},
+		} {
+			if !credential.value.IsNull() {
+				resp.Diagnostics.AddAttributeError(
+					path.Root(credential.name),
+					"Conflicting StorageGrid authentication",
+					fmt.Sprintf("The provider cannot authenticate with %s and with the %s at the same time. "+
+						"Remove the %s value from the configuration or unset %s.", method, credential.name, credential.name, setting),
+				)
+			}
+		}
+
+		if saml && len(samlCommand) == 0 && samlResponse == "" {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("saml_response_command"),
+				"Missing StorageGrid SAML response",
+				"The provider cannot authenticate with single sign-on (SAML) as there is no SAML response of the identity provider. "+
+					"Set the saml_response_command value in the configuration, or use the STORAGEGRID_SAML_RESPONSE_COMMAND "+
+					"or STORAGEGRID_SAML_RESPONSE environment variable.",
+			)
+		}
+	} else {
+		if username == "" {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("username"),
+				"Missing StorageGrid username",
+				"The provider cannot create the StorageGrid API client as there is a missing or empty value for the StorageGrid API username. "+
+					"Set the username value in the configuration or use the STORAGEGRID_USERNAME environment variable. "+
+					"If either is already set, ensure the value is not empty.",
+			)
+		}
+
+		if password == "" {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("password"),
+				"Missing StorageGrid password",
+				"The provider cannot create the StorageGrid API client as there is a missing or empty value for the StorageGrid API password. "+
+					"Set the password value in the configuration or use the STORAGEGRID_PASSWORD environment variable. "+
+					"If either is already set, ensure the value is not empty.",
+			)
+		}
+	}
+
+	if gridAdmin {
+		if !data.Tenant.IsNull() && data.Tenant.ValueString() != "" {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("tenant"),
+				"Conflicting StorageGrid tenant",
+				"The provider cannot authenticate as grid administrator and as tenant user at the same time. "+
+					"Remove the tenant value from the configuration or unset grid_admin. "+
+					"Use a provider alias to manage both the grid and a tenant.",
+			)
+		}
+		// a tenant of the environment is meant for the tenant providers of the configuration
+		tenant = ""
+	} else if tenant == "" && !tokenAuth {
 		resp.Diagnostics.AddAttributeError(
 			path.Root("tenant"),
 			"Missing StorageGrid tenant",
 			"The provider cannot create the StorageGrid API client as there is a missing or empty value for the StorageGrid API tenant. "+
 				"Set the tenant value in the configuration or use the STORAGEGRID_TENANT environment variable. "+
-				"If either is already set, ensure the value is not empty.",
+				"If either is already set, ensure the value is not empty. "+
+				"Set grid_admin to authenticate as grid administrator instead.",
 		)
 	}
 
@@ -209,15 +430,76 @@ func (p *storagegridProvider) Configure(ctx context.Context, req provider.Config
 	ctx = tflog.SetField(ctx, "storagegrid_password", password)
 	ctx = tflog.SetField(ctx, "storagegrid_tenant", tenant)
 
-	clientUsPsw := NewUsernamePasswordClient(
-		address,
-		username,
-		password,
-		tenant,
-		insecure,
-	)
-	bearerToken, _, _ := clientUsPsw.SendAuthorizeRequest(200)
-	client := NewTokenClient(address, bearerToken, insecure)
+	ctx = tflog.SetField(ctx, "storagegrid_grid_admin", gridAdmin)
+
+	ctx = tflog.SetField(ctx, "storagegrid_saml", saml)
+	ctx = tflog.SetField(ctx, "storagegrid_token_file", tokenFile)
+	ctx = tflog.SetField(ctx, "storagegrid_skip_authentication_check", skipAuthenticationCheck)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var client *S3GridClient
+	switch {
+	case tokenAuth:
+		client = NewTokenClient(address, token, insecure)
+		if gridAdmin {
+			client = NewGridTokenClient(address, token, insecure)
+		}
+		client.tokenFile = tokenFile
+	case saml:
+		samlResponseFunc := samlResponseFromValue(samlResponse)
+		if len(samlCommand) > 0 {
+			samlResponseFunc = samlResponseFromCommand(samlCommand)
+		}
+
+		client = NewSamlClient(address, tenant, samlResponseFunc, insecure)
+		if gridAdmin {
+			client = NewGridSamlClient(address, samlResponseFunc, insecure)
+		}
+	default:
+		client = NewUsernamePasswordClient(address, username, password, tenant, insecure)
+		if gridAdmin {
+			client = NewGridUsernamePasswordClient(address, username, password, insecure)
+		}
+	}
+
+	client.maxRetries = int(maxRetries)
+	client.retryMinWait = time.Duration(retryMinWait) * time.Second
+	client.retryMaxWait = time.Duration(retryMaxWait) * time.Second
+
+	// the client keeps its authentication method, to authorize again once the token expires
+	if !skipAuthenticationCheck {
+		err := client.authenticate()
+		var authErr *GenericError
+		switch {
+		case err == nil:
+		case errors.As(err, &authErr):
+			resp.Diagnostics.AddError(authErr.Summary, authErr.Details)
+			return
+		case tokenFile != "":
+			resp.Diagnostics.AddAttributeError(
+				path.Root("token_file"),
+				"Unable to read StorageGrid token file",
+				"The provider cannot read the bearer token, got error: "+err.Error(),
+			)
+			return
+		case saml:
+			resp.Diagnostics.AddError(
+				"Unable to authenticate with StorageGrid SAML",
+				"The provider cannot authenticate with single sign-on (SAML), got error: "+err.Error(),
+			)
+			return
+		default:
+			resp.Diagnostics.AddError(
+				"Unable to authenticate with StorageGrid",
+				"The provider cannot authenticate with the username and password, got error: "+err.Error(),
+			)
+			return
+		}
+	}
+
 	resp.DataSourceData = client
 	resp.ResourceData = client
 
@@ -226,11 +508,31 @@ func (p *storagegridProvider) Configure(ctx context.Context, req provider.Config
 
 func (p *storagegridProvider) Resources(ctx context.Context) []func() resource.Resource {
 	return []func() resource.Resource{
+		NewBucketComplianceResource,
+		NewBucketCorsResource,
+		NewBucketLifecycleConfigurationResource,
+		NewBucketMetadataNotificationResource,
+		NewBucketNotificationResource,
 		NewBucketPolicyResource,
 		NewBucketQuotaResource,
+		NewBucketReplicationResource,
 		NewBucketResource,
+		NewBucketTagsResource,
 		NewBucketVersioningResource,
+		NewEndpointResource,
+		NewGridClientCertificateResource,
+		NewGridGroupResource,
+		NewGridHAGroupResource,
+		NewGridILMPolicyResource,
+		NewGridILMRuleResource,
+		NewGridLoadBalancerEndpointResource,
+		NewGridServerCertificateResource,
+		NewGridStoragePoolResource,
+		NewGridTenantResource,
+		NewGridTrafficClassResource,
+		NewGridUserResource,
 		NewGroupsResource,
+		NewIdentitySourceResource,
 		NewS3AccessSecretKeyCurrentUserResource,
 		NewS3AccessSecretKeyResource,
 		NewUsersResource,
@@ -239,15 +541,21 @@ func (p *storagegridProvider) Resources(ctx context.Context) []func() resource.R
 
 func (p *storagegridProvider) DataSources(ctx context.Context) []func() datasource.DataSource {
 	return []func() datasource.DataSource{
+		NewBucketComplianceDataSource,
+		NewBucketCorsDataSource,
 		NewBucketDataSource,
+		NewBucketMetadataNotificationDataSource,
+		NewBucketNotificationDataSource,
 		NewBucketPolicyDataSource,
 		NewBucketQuotaDataSource,
 		NewBucketVersioningDataSource,
+		NewEndpointsDataSource,
 		NewGroupDataSource,
 		NewGroupsDataSource,
 		NewS3DataSource_ByUserID_AccountID,
 		NewS3DataSource_ByUserID_All,
 		NewTenantConfigDataSource,
+		NewTenantUsageDataSource,
 		NewUserDataSource,
 		NewUsersDataSource,
 	}
@@ -0,0 +1,88 @@
+// Copyright (c) github.com/dmpe
+// SPDX-License-Identifier: MIT
+
+package provider
+
+import (
+	"crypto/tls"
+	"errors"
+	"math/rand/v2"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// The defaults of the retries of the provider. A client of the constructors does not retry.
+const (
+	defaultMaxRetries   = 3
+	defaultRetryMinWait = time.Second
+	defaultRetryMaxWait = 30 * time.Second
+)
+
+// isIdempotent returns true if repeating a request with the given method has the same effect as sending it once.
+func isIdempotent(method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodPut, http.MethodDelete:
+		return true
+	default:
+		return false
+	}
+}
+
+// retryable returns true if the request failed transiently. StorageGRID does not process a request it answers with 429
+// or 503, such requests are repeated for every method. Connection errors and other server errors are only repeated for
+// idempotent methods, as StorageGRID may have processed the request.
+func retryable(method string, resp *http.Response, err error) bool {
+	if err != nil {
+		var verificationErr *tls.CertificateVerificationError
+		return isIdempotent(method) && !errors.As(err, &verificationErr)
+	}
+
+	switch {
+	case resp.StatusCode == http.StatusTooManyRequests || resp.StatusCode == http.StatusServiceUnavailable:
+		return true
+	case resp.StatusCode >= 500 && resp.StatusCode != http.StatusNotImplemented:
+		return isIdempotent(method)
+	default:
+		return false
+	}
+}
+
+// retryWait returns the wait before the given retry, counted from 0. It is the Retry-After of the response, or else an
+// exponential backoff from retryMinWait with jitter. The wait never exceeds retryMaxWait.
+func (c *S3GridClient) retryWait(retry int, resp *http.Response) time.Duration {
+	if resp != nil {
+		if wait, ok := retryAfter(resp.Header.Get("Retry-After")); ok {
+			return min(wait, c.retryMaxWait)
+		}
+	}
+
+	wait := c.retryMaxWait
+	if retry < 32 && c.retryMinWait <= c.retryMaxWait>>retry {
+		wait = c.retryMinWait << retry
+	}
+
+	// half of the backoff is random, so that concurrent requests do not retry at the same time
+	if half := wait / 2; half > 0 {
+		wait = half + rand.N(half)
+	}
+
+	return wait
+}
+
+// retryAfter parses the value of a Retry-After header, which is either a number of seconds or an HTTP date.
+func retryAfter(value string) (time.Duration, bool) {
+	if value == "" {
+		return 0, false
+	}
+
+	if seconds, err := strconv.Atoi(value); err == nil {
+		return max(time.Duration(seconds)*time.Second, 0), true
+	}
+
+	if date, err := http.ParseTime(value); err == nil {
+		return max(time.Until(date), 0), true
+	}
+
+	return 0, false
+}
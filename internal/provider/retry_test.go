@@ -0,0 +1,169 @@
+// Copyright (c) github.com/dmpe
+// SPDX-License-Identifier: MIT
+
+package provider
+
+import (
+	"crypto/tls"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// newFlakyStandIn returns a stand-in for StorageGRID, which fails the given number of requests with the given status
+// code, or resets their connection if the status code is 0, and answers all further requests with 200.
+func newFlakyStandIn(failures int32, statusCode int, retryAfter string, requests *int32) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(requests, 1) > failures {
+			_, _ = w.Write([]byte(`{"data": {}}`))
+			return
+		}
+
+		if statusCode == 0 {
+			conn, _, _ := w.(http.Hijacker).Hijack()
+			_ = conn.Close()
+			return
+		}
+
+		if retryAfter != "" {
+			w.Header().Set("Retry-After", retryAfter)
+		}
+		w.WriteHeader(statusCode)
+		_, _ = w.Write([]byte(`{"code": 503, "message": {"text": "Service Unavailable"}}`))
+	}))
+}
+
+func TestS3GridClient_SendRequest_Retry(t *testing.T) {
+	tests := []struct {
+		name       string
+		method     string
+		failures   int32
+		statusCode int
+		retryAfter string
+		respCode   int
+		requests   int32
+	}{
+		{"service unavailable", "GET", 2, http.StatusServiceUnavailable, "", 200, 3},
+		{"too many requests", "POST", 1, http.StatusTooManyRequests, "0", 200, 2},
+		{"service unavailable of a POST", "POST", 1, http.StatusServiceUnavailable, "", 200, 2},
+		{"server error", "PUT", 1, http.StatusBadGateway, "", 200, 2},
+		{"server error of a POST", "POST", 1, http.StatusBadGateway, "", 502, 1},
+		{"not implemented", "GET", 1, http.StatusNotImplemented, "", 501, 1},
+		{"client error", "GET", 1, http.StatusConflict, "", 409, 1},
+		{"retries exhausted", "DELETE", 5, http.StatusServiceUnavailable, "", 503, 4},
+		{"connection reset", "GET", 1, 0, "", 200, 2},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var requests int32
+			server := newFlakyStandIn(tt.failures, tt.statusCode, tt.retryAfter, &requests)
+			defer server.Close()
+
+			client := NewTokenClient(server.URL, "token", false)
+			client.maxRetries = 3
+			client.retryMinWait = time.Millisecond
+			client.retryMaxWait = 10 * time.Millisecond
+
+			_, _, respCode, err := client.SendRequest(tt.method, "/org/containers", map[string]string{"name": "test"}, 200)
+			assert.Equal(t, tt.respCode, respCode)
+			assert.Equal(t, tt.respCode == 200, err == nil)
+			assert.Equal(t, tt.requests, atomic.LoadInt32(&requests))
+		})
+	}
+}
+
+func TestS3GridClient_SendRequest_NoRetry(t *testing.T) {
+	var requests int32
+	server := newFlakyStandIn(1, http.StatusServiceUnavailable, "", &requests)
+	defer server.Close()
+
+	// a client of the constructors does not retry
+	client := NewTokenClient(server.URL, "token", false)
+	_, _, respCode, err := client.SendRequest("GET", "/org/containers", nil, 200)
+	assert.Error(t, err)
+	assert.Equal(t, 503, respCode)
+	assert.Equal(t, int32(1), requests)
+}
+
+func TestS3GridClient_retryWait(t *testing.T) {
+	client := &S3GridClient{retryMinWait: time.Second, retryMaxWait: 8 * time.Second}
+
+	// the backoff doubles, up to half of it is left out at random
+	for retry, backoff := range []time.Duration{time.Second, 2 * time.Second, 4 * time.Second, 8 * time.Second, 8 * time.Second} {
+		wait := client.retryWait(retry, nil)
+		assert.GreaterOrEqual(t, wait, backoff/2)
+		assert.Less(t, wait, backoff)
+	}
+	assert.LessOrEqual(t, client.retryWait(100, nil), 8*time.Second)
+
+	// the Retry-After of StorageGRID takes precedence, up to the maximum wait
+	resp := &http.Response{Header: http.Header{}}
+	resp.Header.Set("Retry-After", "3")
+	assert.Equal(t, 3*time.Second, client.retryWait(0, resp))
+
+	resp.Header.Set("Retry-After", "120")
+	assert.Equal(t, 8*time.Second, client.retryWait(0, resp))
+
+	resp.Header.Set("Retry-After", time.Now().Add(-time.Minute).UTC().Format(http.TimeFormat))
+	assert.Equal(t, time.Duration(0), client.retryWait(0, resp))
+}
+
+func Test_retryAfter(t *testing.T) {
+	tests := []struct {
+		value string
+		wait  time.Duration
+		ok    bool
+	}{
+		{"", 0, false},
+		{"5", 5 * time.Second, true},
+		{"-5", 0, true},
+		{"soon", 0, false},
+		{time.Now().Add(-time.Hour).UTC().Format(http.TimeFormat), 0, true},
+	}
+
+	for _, tt := range tests {
+		wait, ok := retryAfter(tt.value)
+		assert.Equal(t, tt.wait, wait, tt.value)
+		assert.Equal(t, tt.ok, ok, tt.value)
+	}
+
+	wait, ok := retryAfter(time.Now().Add(time.Hour).UTC().Format(http.TimeFormat))
+	assert.True(t, ok)
+	assert.InDelta(t, time.Hour, wait, float64(2*time.Second))
+}
+
+func Test_retryable(t *testing.T) {
+	tests := []struct {
+		method     string
+		statusCode int
+		err        error
+		retryable  bool
+	}{
+		{"GET", 200, nil, false},
+		{"GET", 404, nil, false},
+		{"POST", 429, nil, true},
+		{"POST", 503, nil, true},
+		{"POST", 500, nil, false},
+		{"PATCH", 504, nil, false},
+		{"GET", 500, nil, true},
+		{"DELETE", 504, nil, true},
+		{"GET", 501, nil, false},
+		{"GET", 0, errors.New("connection reset by peer"), true},
+		{"POST", 0, errors.New("connection reset by peer"), false},
+		{"GET", 0, &tls.CertificateVerificationError{}, false},
+	}
+
+	for _, tt := range tests {
+		var resp *http.Response
+		if tt.err == nil {
+			resp = &http.Response{StatusCode: tt.statusCode}
+		}
+		assert.Equal(t, tt.retryable, retryable(tt.method, resp, tt.err), "%s %d %v", tt.method, tt.statusCode, tt.err)
+	}
+}
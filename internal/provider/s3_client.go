@@ -0,0 +1,202 @@
+// Copyright (c) github.com/dmpe
+// SPDX-License-Identifier: MIT
+
+package provider
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"crypto/tls"
+	"encoding/hex"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+	"time"
+)
+
+// S3DataClient calls the S3 (data-plane) endpoint of StorageGrid. Unlike S3GridClient, which uses the management API
+// with a bearer token, every request is signed with AWS Signature Version 4 using S3 access keys of the tenant.
+// Buckets are always addressed in path-style, e.g. https://s3.example.com:10443/my-bucket?tagging.
+type S3DataClient struct {
+	endpoint        string
+	region          string
+	accessKey       string
+	secretAccessKey string
+	httpClient      *http.Client
+	// now is replaced in tests to produce deterministic signatures
+	now func() time.Time
+}
+
+// S3ErrorXmlModel is the error document returned by the S3 endpoint.
+type S3ErrorXmlModel struct {
+	XMLName xml.Name `xml:"Error"`
+	Code    string   `xml:"Code"`
+	Message string   `xml:"Message"`
+}
+
+// S3Error is returned by S3DataClient.SendRequest if the S3 endpoint answers with an unexpected status code.
+type S3Error struct {
+	StatusCode int
+	Code       string
+	Message    string
+}
+
+func (e *S3Error) Error() string {
+	return fmt.Sprintf("[ERROR] unexpected status code got: %v, S3 error code: %s \n %s", e.StatusCode, e.Code, e.Message)
+}
+
+// NewS3DataClient creates HTTP client object for calling the S3 endpoint, e.g. https://s3.example.com:10443
+func NewS3DataClient(endpoint string, region string, accessKey string, secretAccessKey string, insecure bool) *S3DataClient {
+	httpClient := &http.Client{}
+	if insecure {
+		tr := &http.Transport{
+			Proxy:           http.ProxyFromEnvironment,
+			TLSClientConfig: &tls.Config{InsecureSkipVerify: true},
+		}
+		httpClient = &http.Client{Transport: tr}
+	}
+
+	return &S3DataClient{
+		endpoint:        strings.TrimSuffix(endpoint, "/"),
+		region:          region,
+		accessKey:       accessKey,
+		secretAccessKey: secretAccessKey,
+		httpClient:      httpClient,
+		now:             time.Now,
+	}
+}
+
+// SendRequest sends a signed request for the given bucket and sub-resource, e.g. "tagging". Additional headers, such
+// as Content-MD5, are signed as well. An unexpected status code results in an *S3Error.
+func (c *S3DataClient) SendRequest(method string, bucket string, query string, headers map[string]string, payload []byte, statusCode int) (value []byte, respCode int, err error) {
+	address := c.endpoint + "/" + url.PathEscape(bucket)
+	if query != "" {
+		address += "?" + query
+	}
+
+	req, err := http.NewRequest(method, address, bytes.NewReader(payload))
+	if err != nil {
+		return nil, 0, err
+	}
+
+	for k, v := range headers {
+		req.Header.Set(k, v)
+	}
+
+	payloadHash := sha256.Sum256(payload)
+	signV4(req, hex.EncodeToString(payloadHash[:]), c.accessKey, c.secretAccessKey, c.region, c.now())
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, http.StatusBadGateway, err
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, resp.StatusCode, err
+	}
+
+	if statusCode != 0 && resp.StatusCode != statusCode {
+		s3Err := &S3Error{StatusCode: resp.StatusCode, Message: string(body)}
+		var errorXml S3ErrorXmlModel
+		if xml.Unmarshal(body, &errorXml) == nil {
+			s3Err.Code = errorXml.Code
+			s3Err.Message = errorXml.Message
+		}
+		return nil, resp.StatusCode, s3Err
+	}
+
+	return body, resp.StatusCode, nil
+}
+
+// signV4 adds the AWS Signature Version 4 headers (x-amz-date, x-amz-content-sha256 and Authorization) to the request.
+// All headers already set on the request, as well as the host, are signed.
+// See https://docs.aws.amazon.com/AmazonS3/latest/API/sig-v4-header-based-auth.html
+func signV4(req *http.Request, payloadHash string, accessKey string, secretAccessKey string, region string, t time.Time) {
+	amzDate := t.UTC().Format("20060102T150405Z")
+	date := amzDate[:8]
+
+	req.Header.Set("x-amz-date", amzDate)
+	req.Header.Set("x-amz-content-sha256", payloadHash)
+
+	canonicalHeaders := map[string]string{"host": req.URL.Host}
+	for k, v := range req.Header {
+		canonicalHeaders[strings.ToLower(k)] = strings.TrimSpace(strings.Join(v, ","))
+	}
+
+	names := make([]string, 0, len(canonicalHeaders))
+	for k := range canonicalHeaders {
+		names = append(names, k)
+	}
+	sort.Strings(names)
+
+	var headerLines strings.Builder
+	for _, k := range names {
+		headerLines.WriteString(k + ":" + canonicalHeaders[k] + "\n")
+	}
+	signedHeaders := strings.Join(names, ";")
+
+	canonicalURI := req.URL.EscapedPath()
+	if canonicalURI == "" {
+		canonicalURI = "/"
+	}
+
+	canonicalRequest := strings.Join([]string{
+		req.Method,
+		canonicalURI,
+		canonicalQueryString(req.URL),
+		headerLines.String(),
+		signedHeaders,
+		payloadHash,
+	}, "\n")
+
+	scope := date + "/" + region + "/s3/aws4_request"
+	canonicalHash := sha256.Sum256([]byte(canonicalRequest))
+	stringToSign := "AWS4-HMAC-SHA256\n" + amzDate + "\n" + scope + "\n" + hex.EncodeToString(canonicalHash[:])
+
+	key := hmacSHA256([]byte("AWS4"+secretAccessKey), date)
+	key = hmacSHA256(key, region)
+	key = hmacSHA256(key, "s3")
+	key = hmacSHA256(key, "aws4_request")
+	signature := hex.EncodeToString(hmacSHA256(key, stringToSign))
+
+	req.Header.Set("Authorization", fmt.Sprintf("AWS4-HMAC-SHA256 Credential=%s/%s, SignedHeaders=%s, Signature=%s", accessKey, scope, signedHeaders, signature))
+}
+
+// canonicalQueryString sorts the query parameters and encodes them as required by Signature Version 4, i.e. spaces
+// as %20 and parameters without value, such as "tagging", as "tagging=".
+func canonicalQueryString(u *url.URL) string {
+	query := u.Query()
+	keys := make([]string, 0, len(query))
+	for k := range query {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	var params []string
+	for _, k := range keys {
+		values := query[k]
+		sort.Strings(values)
+		for _, v := range values {
+			params = append(params, s3Escape(k)+"="+s3Escape(v))
+		}
+	}
+
+	return strings.Join(params, "&")
+}
+
+func s3Escape(s string) string {
+	return strings.ReplaceAll(url.QueryEscape(s), "+", "%20")
+}
+
+func hmacSHA256(key []byte, data string) []byte {
+	h := hmac.New(sha256.New, key)
+	h.Write([]byte(data))
+	return h.Sum(nil)
+}
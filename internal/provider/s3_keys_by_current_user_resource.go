@@ -96,6 +96,8 @@ func (r *s3AccessSecretKeyCurrentUserResource) Configure(ctx context.Context, re
 		return
 	}
 
+	resp.Diagnostics.Append(requireTenantScope(client)...)
+
 	r.client = client
 }
 
@@ -89,6 +89,8 @@ func (d *s3UserIDSpecificAccessKeyDataSource) Configure(ctx context.Context, req
 		return
 	}
 
+	resp.Diagnostics.Append(requireTenantScope(client)...)
+
 	d.client = client
 }
 
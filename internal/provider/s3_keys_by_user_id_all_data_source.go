@@ -88,6 +88,8 @@ func (d *s3UserIDAllKeysDataSource) Configure(ctx context.Context, req datasourc
 		return
 	}
 
+	resp.Diagnostics.Append(requireTenantScope(client)...)
+
 	d.client = client
 }
 
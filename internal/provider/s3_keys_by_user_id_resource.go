@@ -104,6 +104,8 @@ func (r *s3AccessSecretKeyResource) Configure(ctx context.Context, req resource.
 		return
 	}
 
+	resp.Diagnostics.Append(requireTenantScope(client)...)
+
 	r.client = client
 }
 
@@ -0,0 +1,145 @@
+// Copyright (c) github.com/dmpe
+// SPDX-License-Identifier: MIT
+
+package provider
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"os/exec"
+	"strings"
+	"sync/atomic"
+)
+
+// SamlResponseFunc returns the base64 encoded SAML response of the identity provider for the given SAML request URL,
+// i.e. the URL of the identity provider StorageGRID redirects a browser to.
+type SamlResponseFunc func(requestURL string) (string, error)
+
+// samlResponseFromValue returns a SamlResponseFunc which returns the given SAML response, e.g. of the
+// STORAGEGRID_SAML_RESPONSE environment variable, once. Identity providers issue a SAML response for a single SAML
+// request, so a static SAML response cannot renew the token and fails with ErrSamlResponseNotRenewable afterwards.
+func samlResponseFromValue(samlResponse string) SamlResponseFunc {
+	var used atomic.Bool
+	return func(string) (string, error) {
+		if used.Swap(true) {
+			return "", ErrSamlResponseNotRenewable
+		}
+		return strings.TrimSpace(samlResponse), nil
+	}
+}
+
+// samlResponseFromCommand returns a SamlResponseFunc which runs the given helper command. The command gets the SAML
+// request URL in the STORAGEGRID_SAML_REQUEST_URL environment variable and prints the SAML response to stdout.
+func samlResponseFromCommand(command []string) SamlResponseFunc {
+	return func(requestURL string) (string, error) {
+		var stdout, stderr bytes.Buffer
+
+		cmd := exec.Command(command[0], command[1:]...)
+		cmd.Env = append(os.Environ(), "STORAGEGRID_SAML_REQUEST_URL="+requestURL)
+		cmd.Stdout = &stdout
+		cmd.Stderr = &stderr
+
+		if err := cmd.Run(); err != nil {
+			return "", fmt.Errorf("SAML response command %q failed: %w: %s", command[0], err, strings.TrimSpace(stderr.String()))
+		}
+
+		samlResponse := strings.TrimSpace(stdout.String())
+		if samlResponse == "" {
+			return "", fmt.Errorf("SAML response command %q printed no SAML response", command[0])
+		}
+
+		return samlResponse, nil
+	}
+}
+
+// SendAuthorizeSamlRequest creates the Bearer Token with the SAML flow of StorageGRID: the authorize-saml endpoint
+// returns the SAML request URL of the identity provider, whose SAML response is then posted with the relay state to
+// the saml-response endpoint, like a browser would do.
+func (c *S3GridClient) SendAuthorizeSamlRequest(statusCode int) (tokenValue string, respCode int, err error) {
+	var jsonD S3GridClientReturnJson
+
+	client := c.client()
+
+	b := new(bytes.Buffer)
+	err = json.NewEncoder(b).Encode(&S3GridClientSamlJson{AccountId: c.tenant})
+	if err != nil {
+		return "", 0, err
+	}
+
+	req, err := http.NewRequest("POST", c.address+api_suffix+api_auth_saml, b)
+	if err != nil {
+		return "", 0, err
+	}
+
+	req.Header.Add("accept", "application/json")
+	req.Header.Add("Content-Type", "application/json")
+
+	body, respCode, err := doSamlRequest(client, req, statusCode)
+	if err != nil {
+		return "", respCode, err
+	}
+
+	if err := json.Unmarshal(body, &jsonD); err != nil {
+		return "", respCode, err
+	}
+
+	requestURL, err := url.Parse(jsonD.Data)
+	if err != nil {
+		return "", respCode, fmt.Errorf("unable to parse SAML request URL: %w", err)
+	}
+
+	samlResponse, err := c.samlResponse(jsonD.Data)
+	if err != nil {
+		return "", 0, err
+	}
+
+	form := url.Values{
+		"SAMLResponse": {samlResponse},
+		"RelayState":   {requestURL.Query().Get("RelayState")},
+	}
+
+	req, err = http.NewRequest("POST", c.address+api_saml_response, strings.NewReader(form.Encode()))
+	if err != nil {
+		return "", 0, err
+	}
+
+	req.Header.Add("accept", "application/json")
+	req.Header.Add("Content-Type", "application/x-www-form-urlencoded")
+
+	body, respCode, err = doSamlRequest(client, req, statusCode)
+	if err != nil {
+		return "", respCode, err
+	}
+
+	if err := json.Unmarshal(body, &jsonD); err != nil {
+		return "", respCode, err
+	}
+
+	// returns JSON body - see provider.go
+	return jsonD.Data, respCode, nil
+}
+
+// doSamlRequest sends a request of the SAML flow and returns the body of the response.
+func doSamlRequest(client *http.Client, req *http.Request, statusCode int) ([]byte, int, error) {
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, http.StatusBadGateway, connectionError(req.URL.Scheme+"://"+req.URL.Host, err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, resp.StatusCode, err
+	}
+
+	if statusCode != 0 && resp.StatusCode != statusCode {
+		return nil, resp.StatusCode, fmt.Errorf("[ERROR] unexpected status code got: %v expected: %v \n %v", resp.StatusCode, statusCode, string(body))
+	}
+
+	return body, resp.StatusCode, nil
+}
@@ -0,0 +1,91 @@
+// Copyright (c) github.com/dmpe
+// SPDX-License-Identifier: MIT
+
+package provider
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// newSamlStandIn returns a stand-in for the SAML flow of StorageGRID, which accepts the given SAML response only.
+func newSamlStandIn(t *testing.T, samlResponse string) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/v4/authorize-saml":
+			var payload S3GridClientSamlJson
+			assert.NoError(t, json.NewDecoder(r.Body).Decode(&payload))
+			_, _ = w.Write([]byte(`{"data": "https://idp.example.com/adfs/ls/?SAMLRequest=request&RelayState=` + payload.AccountId + `"}`))
+		case "/api/saml-response":
+			assert.NoError(t, r.ParseForm())
+			if r.PostForm.Get("SAMLResponse") != samlResponse {
+				w.WriteHeader(http.StatusUnauthorized)
+				_, _ = w.Write([]byte(`{"code": 401, "message": {"text": "Unauthorized"}}`))
+				return
+			}
+			_, _ = w.Write([]byte(`{"data": "token-for-` + r.PostForm.Get("RelayState") + `"}`))
+		case "/api/v4/org/containers":
+			// the token has already expired
+			w.WriteHeader(http.StatusUnauthorized)
+			_, _ = w.Write([]byte(`{"code": 401, "message": {"text": "Unauthorized"}}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+}
+
+func TestS3GridClient_SendAuthorizeSamlRequest(t *testing.T) {
+	server := newSamlStandIn(t, "assertion")
+	defer server.Close()
+
+	var gotRequestURL string
+	client := NewSamlClient(server.URL, "27983238148563830000", func(requestURL string) (string, error) {
+		gotRequestURL = requestURL
+		return "assertion", nil
+	}, false)
+
+	token, respCode, err := client.SendAuthorizeSamlRequest(200)
+	assert.NoError(t, err)
+	assert.Equal(t, 200, respCode)
+	assert.Equal(t, "token-for-27983238148563830000", token)
+	assert.Equal(t, "https://idp.example.com/adfs/ls/?SAMLRequest=request&RelayState=27983238148563830000", gotRequestURL)
+
+	// the grid administrators sign in with the account ID "0"
+	token, _, err = NewGridSamlClient(server.URL, samlResponseFromValue("assertion\n"), false).SendAuthorizeSamlRequest(200)
+	assert.NoError(t, err)
+	assert.Equal(t, "token-for-0", token)
+
+	_, respCode, err = NewGridSamlClient(server.URL, samlResponseFromValue("other"), false).SendAuthorizeSamlRequest(200)
+	assert.Error(t, err)
+	assert.Equal(t, 401, respCode)
+}
+
+func TestS3GridClient_SendRequest_StaticSamlResponse(t *testing.T) {
+	server := newSamlStandIn(t, "assertion")
+	defer server.Close()
+
+	client := NewSamlClient(server.URL, "27983238148563830000", samlResponseFromValue("assertion"), false)
+	assert.NoError(t, client.authenticate())
+	assert.Equal(t, "token-for-27983238148563830000", client.token)
+
+	// a static SAML response cannot renew the expired token
+	_, _, respCode, err := client.SendRequest("GET", "/org/containers", nil, 200)
+	assert.ErrorIs(t, err, ErrSamlResponseNotRenewable)
+	assert.Equal(t, 401, respCode)
+}
+
+func Test_samlResponseFromCommand(t *testing.T) {
+	samlResponse, err := samlResponseFromCommand([]string{"sh", "-c", `echo "assertion for $STORAGEGRID_SAML_REQUEST_URL"`})("https://idp.example.com/")
+	assert.NoError(t, err)
+	assert.Equal(t, "assertion for https://idp.example.com/", samlResponse)
+
+	_, err = samlResponseFromCommand([]string{"sh", "-c", "echo 'IdP not reachable' >&2; exit 1"})("https://idp.example.com/")
+	assert.ErrorContains(t, err, "IdP not reachable")
+
+	_, err = samlResponseFromCommand([]string{"true"})("https://idp.example.com/")
+	assert.ErrorContains(t, err, "printed no SAML response")
+}
@@ -0,0 +1,42 @@
+// Copyright (c) github.com/dmpe
+// SPDX-License-Identifier: MIT
+
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+)
+
+// requireTenantScope reports an error if the client is authenticated as grid administrator. The Tenant Management API
+// (/org/*) is only available to tenant users, hence these resources and data sources would fail on every request.
+func requireTenantScope(client *S3GridClient) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if client.gridAdmin {
+		diags.AddError(
+			"Tenant Provider Required",
+			"This resource or data source manages objects of a tenant through the Tenant Management API, "+
+				"but the provider is configured with 'grid_admin = true'. "+
+				"Use a provider (alias) which is configured with 'tenant' instead.",
+		)
+	}
+
+	return diags
+}
+
+// requireGridScope reports an error if the client is authenticated as tenant user. The Grid Management API (/grid/*
+// and /private/*) is only available to grid administrators.
+func requireGridScope(client *S3GridClient) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if !client.gridAdmin {
+		diags.AddError(
+			"Grid Administrator Provider Required",
+			"This resource or data source manages the grid through the Grid Management API, "+
+				"but the provider is configured as tenant user. "+
+				"Use a provider (alias) which is configured with 'grid_admin = true' instead.",
+		)
+	}
+
+	return diags
+}
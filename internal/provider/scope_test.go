@@ -0,0 +1,21 @@
+// Copyright (c) github.com/dmpe
+// SPDX-License-Identifier: MIT
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRequireScope(t *testing.T) {
+	tenantClient := NewTokenClient("https://grid.example.com", "token", false)
+	gridClient := NewGridTokenClient("https://grid.example.com", "token", false)
+
+	assert.False(t, requireTenantScope(tenantClient).HasError())
+	assert.True(t, requireTenantScope(gridClient).HasError())
+
+	assert.False(t, requireGridScope(gridClient).HasError())
+	assert.True(t, requireGridScope(tenantClient).HasError())
+}
@@ -0,0 +1,33 @@
+// Copyright (c) github.com/dmpe
+// SPDX-License-Identifier: MIT
+
+package provider
+
+import "fmt"
+
+type stubResponse struct {
+	body string
+	code int
+	err  error
+}
+
+// stubHttpClient is a HttpClient which answers the requests with the given responses, in order. It records every
+// request, including its payload.
+type stubHttpClient struct {
+	responses []stubResponse
+	requests  []string
+	payloads  []interface{}
+}
+
+func (c *stubHttpClient) SendRequest(method string, path string, payload interface{}, _ int) ([]byte, string, int, error) {
+	c.requests = append(c.requests, method+" "+path)
+	c.payloads = append(c.payloads, payload)
+
+	if len(c.responses) == 0 {
+		return nil, "", 500, fmt.Errorf("unexpected request %s %s", method, path)
+	}
+
+	resp := c.responses[0]
+	c.responses = c.responses[1:]
+	return []byte(resp.body), "", resp.code, resp.err
+}
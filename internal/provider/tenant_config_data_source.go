@@ -218,6 +218,8 @@ func (d *tenantConfigDataSource) Configure(_ context.Context, req datasource.Con
 		return
 	}
 
+	resp.Diagnostics.Append(requireTenantScope(client)...)
+
 	d.client = client
 }
 
@@ -0,0 +1,95 @@
+// Copyright (c) github.com/dmpe
+// SPDX-License-Identifier: MIT
+
+package provider
+
+import (
+	"encoding/json"
+	"fmt"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type TenantUsageModel struct {
+	IncludeBuckets  []types.String           `tfsdk:"include_buckets"`
+	CalculationTime types.String             `tfsdk:"calculation_time"`
+	ObjectCount     types.Int64              `tfsdk:"object_count"`
+	DataBytes       types.Int64              `tfsdk:"data_bytes"`
+	Buckets         []TenantUsageBucketModel `tfsdk:"buckets"`
+}
+
+type TenantUsageBucketModel struct {
+	Name        types.String `tfsdk:"name"`
+	ObjectCount types.Int64  `tfsdk:"object_count"`
+	DataBytes   types.Int64  `tfsdk:"data_bytes"`
+}
+
+type TenantUsageApiModel struct {
+	CalculationTime string                      `json:"calculationTime"`
+	ObjectCount     int64                       `json:"objectCount"`
+	DataBytes       int64                       `json:"dataBytes"`
+	Buckets         []TenantUsageBucketApiModel `json:"buckets"`
+}
+
+type TenantUsageBucketApiModel struct {
+	Name        string `json:"name"`
+	ObjectCount int64  `json:"objectCount"`
+	DataBytes   int64  `json:"dataBytes"`
+}
+
+// read fetches the usage of the tenant. It returns the names of the requested buckets, which are not part of the
+// usage report, e.g. because they have been created after the usage has been calculated.
+func (m *TenantUsageModel) read(client HttpClient) (*TenantUsageModel, []string, error) {
+	respBody, _, _, err := client.SendRequest("GET", api_usage, nil, 200)
+	if err != nil {
+		return nil, nil, fmt.Errorf("unable to read tenant usage: %w", err)
+	}
+
+	return NewTenantUsageModel(m.IncludeBuckets, respBody)
+}
+
+// NewTenantUsageModel parses the JSON response from the API into a TenantUsageModel. If includeBuckets is not nil,
+// only these buckets are kept. The totals always cover all buckets of the tenant.
+func NewTenantUsageModel(includeBuckets []types.String, input []byte) (*TenantUsageModel, []string, error) {
+	type responseDataType struct {
+		Data TenantUsageApiModel `json:"data"`
+	}
+
+	var returnBody responseDataType
+	if err := json.Unmarshal(input, &returnBody); err != nil {
+		return nil, nil, &GenericError{Summary: "Client Error", Details: "Unable to parse tenant usage response, got error: " + err.Error()}
+	}
+
+	model := &TenantUsageModel{
+		IncludeBuckets:  includeBuckets,
+		CalculationTime: types.StringValue(returnBody.Data.CalculationTime),
+		ObjectCount:     types.Int64Value(returnBody.Data.ObjectCount),
+		DataBytes:       types.Int64Value(returnBody.Data.DataBytes),
+		Buckets:         []TenantUsageBucketModel{},
+	}
+
+	filter := toJson(includeBuckets)
+	var found []string
+
+	for _, bucket := range returnBody.Data.Buckets {
+		if includeBuckets != nil && !slices.Contains(filter, bucket.Name) {
+			continue
+		}
+		found = append(found, bucket.Name)
+		model.Buckets = append(model.Buckets, TenantUsageBucketModel{
+			Name:        types.StringValue(bucket.Name),
+			ObjectCount: types.Int64Value(bucket.ObjectCount),
+			DataBytes:   types.Int64Value(bucket.DataBytes),
+		})
+	}
+
+	var missing []string
+	for _, name := range filter {
+		if !slices.Contains(found, name) {
+			missing = append(missing, name)
+		}
+	}
+
+	return model, missing, nil
+}
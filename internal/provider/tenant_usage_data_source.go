@@ -0,0 +1,137 @@
+// Copyright (c) github.com/dmpe
+// SPDX-License-Identifier: MIT
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider-defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &tenantUsageDataSource{}
+var _ datasource.DataSourceWithConfigure = &tenantUsageDataSource{}
+
+// NewTenantUsageDataSource returns a new data source instance.
+func NewTenantUsageDataSource() datasource.DataSource {
+	return &tenantUsageDataSource{}
+}
+
+// tenantUsageDataSource defines the data source implementation.
+type tenantUsageDataSource struct {
+	client *S3GridClient
+}
+
+func (d *tenantUsageDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_tenant_usage"
+}
+
+func (d *tenantUsageDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `
+Fetch the storage usage of the tenant, in total and per bucket.
+
+**Note:**
+- The usage is calculated periodically by StorageGrid, see 'calculation_time'. Recently created buckets or objects might be missing.
+- The totals always cover all buckets of the tenant, also if 'include_buckets' is set.
+`,
+		Attributes: map[string]schema.Attribute{
+			"include_buckets": schema.ListAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+				Description: "Only report the usage of these buckets. Defaults to all buckets of the tenant.",
+				Validators: []validator.List{
+					listvalidator.UniqueValues(),
+				},
+			},
+			"calculation_time": schema.StringAttribute{
+				Computed:    true,
+				Description: "The time at which the usage was calculated.",
+			},
+			"object_count": schema.Int64Attribute{
+				Computed:    true,
+				Description: "The number of objects of the tenant.",
+			},
+			"data_bytes": schema.Int64Attribute{
+				Computed:    true,
+				Description: "The logical size of all objects of the tenant in bytes.",
+			},
+			"buckets": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "The usage per bucket.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							Computed:    true,
+							Description: "The name of the bucket",
+						},
+						"object_count": schema.Int64Attribute{
+							Computed:    true,
+							Description: "The number of objects in the bucket.",
+						},
+						"data_bytes": schema.Int64Attribute{
+							Computed:    true,
+							Description: "The logical size of all objects in the bucket in bytes.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *tenantUsageDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*S3GridClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	resp.Diagnostics.Append(requireTenantScope(client)...)
+
+	d.client = client
+}
+
+func (d *tenantUsageDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state TenantUsageModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	usage, missing, err := state.read(d.client)
+	if err != nil {
+		resp.Diagnostics.AddError("Error Reading StorageGrid tenant usage", err.Error())
+		return
+	}
+
+	if len(missing) > 0 {
+		resp.Diagnostics.AddWarning(
+			"Buckets without usage",
+			fmt.Sprintf("The usage report does not contain the buckets %s. They might not exist or have been created after the usage has been calculated.", strings.Join(missing, ", ")),
+		)
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, usage)...)
+}
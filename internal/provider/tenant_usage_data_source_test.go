@@ -0,0 +1,32 @@
+// Copyright (c) github.com/dmpe
+// SPDX-License-Identifier: MIT
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestTenantUsageDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"storagegrid": providerserver.NewProtocol6WithError(New("test")()),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: `data "storagegrid_tenant_usage" "test" { include_buckets = ["tf-provider-acc-test-bucket"] }`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.storagegrid_tenant_usage.test", "calculation_time"),
+					resource.TestCheckResourceAttrSet("data.storagegrid_tenant_usage.test", "object_count"),
+					resource.TestCheckResourceAttrSet("data.storagegrid_tenant_usage.test", "data_bytes"),
+					resource.TestCheckResourceAttr("data.storagegrid_tenant_usage.test", "buckets.#", "1"),
+					resource.TestCheckResourceAttr("data.storagegrid_tenant_usage.test", "buckets.0.name", "tf-provider-acc-test-bucket"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,60 @@
+// Copyright (c) github.com/dmpe
+// SPDX-License-Identifier: MIT
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+)
+
+const tenantUsageResponse = `{"data": {
+	"calculationTime": "2025-01-01T12:00:00.000Z",
+	"objectCount": 15,
+	"dataBytes": 3000,
+	"buckets": [
+		{"name": "bucket-a", "objectCount": 10, "dataBytes": 1000},
+		{"name": "bucket-b", "objectCount": 5, "dataBytes": 2000}
+	]
+}}`
+
+func TestTenantUsageModel_Read(t *testing.T) {
+	client := &stubHttpClient{responses: []stubResponse{{body: tenantUsageResponse, code: 200}}}
+
+	model := TenantUsageModel{}
+	usage, missing, err := model.read(client)
+
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"GET /org/usage"}, client.requests)
+	assert.Empty(t, missing)
+	assert.Nil(t, usage.IncludeBuckets)
+	assert.Equal(t, types.StringValue("2025-01-01T12:00:00.000Z"), usage.CalculationTime)
+	assert.Equal(t, types.Int64Value(15), usage.ObjectCount)
+	assert.Equal(t, types.Int64Value(3000), usage.DataBytes)
+	assert.Equal(t, []TenantUsageBucketModel{
+		{Name: types.StringValue("bucket-a"), ObjectCount: types.Int64Value(10), DataBytes: types.Int64Value(1000)},
+		{Name: types.StringValue("bucket-b"), ObjectCount: types.Int64Value(5), DataBytes: types.Int64Value(2000)},
+	}, usage.Buckets)
+}
+
+func TestNewTenantUsageModel_IncludeBuckets(t *testing.T) {
+	include := []types.String{types.StringValue("bucket-b"), types.StringValue("bucket-c")}
+
+	usage, missing, err := NewTenantUsageModel(include, []byte(tenantUsageResponse))
+
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"bucket-c"}, missing)
+	assert.Equal(t, include, usage.IncludeBuckets)
+	// totals are not filtered
+	assert.Equal(t, types.Int64Value(15), usage.ObjectCount)
+	assert.Equal(t, []TenantUsageBucketModel{
+		{Name: types.StringValue("bucket-b"), ObjectCount: types.Int64Value(5), DataBytes: types.Int64Value(2000)},
+	}, usage.Buckets)
+}
+
+func TestNewTenantUsageModel_InvalidResponse(t *testing.T) {
+	_, _, err := NewTenantUsageModel(nil, []byte(`not json`))
+	assert.ErrorIs(t, err, &GenericError{Summary: "Client Error"})
+}
@@ -92,6 +92,8 @@ func (d *usersDataSource) Configure(ctx context.Context, req datasource.Configur
 		return
 	}
 
+	resp.Diagnostics.Append(requireTenantScope(client)...)
+
 	d.client = client
 }
 
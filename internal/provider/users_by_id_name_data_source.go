@@ -25,6 +25,12 @@ func NewUserDataSource() datasource.DataSource {
 	return &userDataSource{}
 }
 
+// userDataSourceModel extends the user model with the opt-in synchronization of the identity source.
+type userDataSourceModel struct {
+	usersDataSourceDataModel
+	IdentitySourceSynchronizeModel
+}
+
 // userDataSource defines the data source implementation.
 type userDataSource struct {
 	client *S3GridClient
@@ -80,6 +86,14 @@ func (d *userDataSource) Schema(ctx context.Context, req datasource.SchemaReques
 			"account_id": schema.StringAttribute{
 				Computed: true,
 			},
+			"synchronize_identity_source": schema.BoolAttribute{
+				Optional:    true,
+				Description: "Synchronize the identity source if a federated user is not found by its unique name, e.g. if it has just been added to the LDAP server. The user is fetched until it is found or 'synchronize_timeout' has passed. Defaults to 'false'.",
+			},
+			"synchronize_timeout": schema.StringAttribute{
+				Optional:    true,
+				Description: "How long to wait for the federated user after the synchronization of the identity source, e.g. '30s' or '5m'. Defaults to '2m'.",
+			},
 		},
 	}
 }
@@ -101,11 +115,13 @@ func (d *userDataSource) Configure(ctx context.Context, req datasource.Configure
 		return
 	}
 
+	resp.Diagnostics.Append(requireTenantScope(client)...)
+
 	d.client = client
 }
 
 func (d *userDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
-	var state usersDataSourceDataModel
+	var state userDataSourceModel
 	var jsonData UsersDataModelSingle
 	var newDiags diag.Diagnostics
 	var idType types.String
@@ -127,7 +143,21 @@ func (d *userDataSource) Read(ctx context.Context, req datasource.ReadRequest, r
 	} else {
 		fullPath = api_users + "/" + uniqueNameType.ValueString()
 	}
-	rp, _, _, err := d.client.SendRequest("GET", fullPath, nil, 200)
+
+	var rp []byte
+	var err error
+	if state.SynchronizeIdentitySource.ValueBool() && state.ID.IsNull() && isFederated(uniqueNameType.ValueString()) {
+		timeout, timeoutErr := state.timeout()
+		if timeoutErr != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("synchronize_timeout"), "Invalid Synchronize Timeout", timeoutErr.Error())
+			return
+		}
+
+		tflog.Debug(ctx, "Fetching the federated user, synchronizing the identity source if it is not found.")
+		rp, err = readFederatedPrincipal(ctx, d.client, fullPath, timeout)
+	} else {
+		rp, _, _, err = d.client.SendRequest("GET", fullPath, nil, 200)
+	}
 
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read example, got error: %s", err))
@@ -158,7 +188,7 @@ func (d *userDataSource) Read(ctx context.Context, req datasource.ReadRequest, r
 		MemberOf:   groupMembers,
 	}
 
-	state = *usersData
+	state.usersDataSourceDataModel = *usersData
 
 	resp.Diagnostics.Append(newDiags...)
 	// Write logs using the tflog package
@@ -118,6 +118,8 @@ func (r *usersResource) Configure(ctx context.Context, req resource.ConfigureReq
 		return
 	}
 
+	resp.Diagnostics.Append(requireTenantScope(client)...)
+
 	r.client = client
 }
 